@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cfutil
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// AccessTokenEnvVar is the environment variable which may be set to a static access token, for example in CI
+// pipelines where the cf CLI is not logged in interactively.
+const AccessTokenEnvVar = "SCDF_ACCESS_TOKEN"
+
+// NewTokenSource returns a token source which uses the static access token in the SCDF_ACCESS_TOKEN environment
+// variable, if it is set, or otherwise obtains access tokens from the cf CLI.
+func NewTokenSource(cliConnection plugin.CliConnection) httpclient.TokenSource {
+	if token := strings.TrimSpace(os.Getenv(AccessTokenEnvVar)); token != "" {
+		return NewStaticTokenSource(token)
+	}
+	return NewCliTokenSource(cliConnection)
+}
+
+type cliTokenSource struct {
+	cliConnection plugin.CliConnection
+	token         string
+	mutex         sync.Mutex
+}
+
+// NewCliTokenSource returns a token source which obtains access tokens from the cf CLI. The cf CLI refreshes the
+// access token when it has expired.
+func NewCliTokenSource(cliConnection plugin.CliConnection) *cliTokenSource {
+	return &cliTokenSource{cliConnection: cliConnection}
+}
+
+func (t *cliTokenSource) Token() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.token != "" {
+		return t.token, nil
+	}
+	return t.refresh()
+}
+
+func (t *cliTokenSource) Refresh() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.refresh()
+}
+
+func (t *cliTokenSource) refresh() (string, error) {
+	token, err := GetToken(t.cliConnection)
+	if err != nil {
+		return "", err
+	}
+	t.token = token
+	return token, nil
+}
+
+type staticTokenSource struct {
+	token string
+}
+
+// NewStaticTokenSource returns a token source which always returns the given access token. The token may optionally
+// be prefixed with "bearer ", as in the output of "cf oauth-token".
+func NewStaticTokenSource(token string) *staticTokenSource {
+	fields := strings.Fields(token)
+	if len(fields) == 2 && strings.EqualFold(fields[0], "bearer") {
+		token = fields[1]
+	}
+	return &staticTokenSource{token: token}
+}
+
+func (t *staticTokenSource) Token() (string, error) {
+	return t.token, nil
+}
+
+func (t *staticTokenSource) Refresh() (string, error) {
+	return t.token, nil
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cfutil_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cfutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+var _ = Describe("TokenSource", func() {
+	const (
+		testToken      = "some-token"
+		refreshedToken = "refreshed-token"
+	)
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		tokenSource       httpclient.TokenSource
+		tok               string
+		err               error
+	)
+
+	BeforeEach(func() {
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		tokens := []string{"bearer " + testToken, "bearer " + refreshedToken}
+		fakeCliConnection.AccessTokenStub = func() (string, error) {
+			token := tokens[0]
+			tokens = tokens[1:]
+			return token, nil
+		}
+	})
+
+	Describe("NewCliTokenSource", func() {
+		BeforeEach(func() {
+			tokenSource = cfutil.NewCliTokenSource(fakeCliConnection)
+		})
+
+		It("should obtain the token from the cf CLI once", func() {
+			tok, err = tokenSource.Token()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tok).To(Equal(testToken))
+
+			tok, err = tokenSource.Token()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tok).To(Equal(testToken))
+			Expect(fakeCliConnection.AccessTokenCallCount()).To(Equal(1))
+		})
+
+		It("should obtain a new token from the cf CLI when refreshed", func() {
+			_, err = tokenSource.Token()
+			Expect(err).NotTo(HaveOccurred())
+
+			tok, err = tokenSource.Refresh()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tok).To(Equal(refreshedToken))
+
+			tok, err = tokenSource.Token()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tok).To(Equal(refreshedToken))
+			Expect(fakeCliConnection.AccessTokenCallCount()).To(Equal(2))
+		})
+
+		Context("when the token is not available", func() {
+			BeforeEach(func() {
+				fakeCliConnection.AccessTokenStub = nil
+				fakeCliConnection.AccessTokenReturns("", errors.New("no dice"))
+			})
+
+			It("should propagate the error", func() {
+				_, err = tokenSource.Token()
+				Expect(err).To(MatchError("Access token not available: no dice"))
+			})
+		})
+	})
+
+	Describe("NewStaticTokenSource", func() {
+		It("should return the token when obtained or refreshed", func() {
+			tokenSource = cfutil.NewStaticTokenSource(testToken)
+			Expect(tokenSource.Token()).To(Equal(testToken))
+			Expect(tokenSource.Refresh()).To(Equal(testToken))
+		})
+
+		It("should accept the output of cf oauth-token", func() {
+			tokenSource = cfutil.NewStaticTokenSource("bearer " + testToken + "\n")
+			Expect(tokenSource.Token()).To(Equal(testToken))
+		})
+	})
+
+	Describe("NewTokenSource", func() {
+		var (
+			savedToken string
+			wasSet     bool
+		)
+
+		BeforeEach(func() {
+			savedToken, wasSet = os.LookupEnv(cfutil.AccessTokenEnvVar)
+		})
+
+		AfterEach(func() {
+			if wasSet {
+				os.Setenv(cfutil.AccessTokenEnvVar, savedToken)
+			} else {
+				os.Unsetenv(cfutil.AccessTokenEnvVar)
+			}
+		})
+
+		Context("when the access token environment variable is set", func() {
+			BeforeEach(func() {
+				os.Setenv(cfutil.AccessTokenEnvVar, "static-token")
+			})
+
+			It("should use the static token without consulting the cf CLI", func() {
+				tokenSource = cfutil.NewTokenSource(fakeCliConnection)
+				Expect(tokenSource.Token()).To(Equal("static-token"))
+				Expect(fakeCliConnection.AccessTokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the access token environment variable is not set", func() {
+			BeforeEach(func() {
+				os.Unsetenv(cfutil.AccessTokenEnvVar)
+			})
+
+			It("should obtain the token from the cf CLI", func() {
+				tokenSource = cfutil.NewTokenSource(fakeCliConnection)
+				Expect(tokenSource.Token()).To(Equal(testToken))
+				Expect(fakeCliConnection.AccessTokenCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -41,6 +41,11 @@ func NewArgConsumer(positionalArgs []string, diagnose DiagnosticFunc) *ArgConsum
 	}
 }
 
+// Command returns the name of the command whose arguments are consumed.
+func (ac *ArgConsumer) Command() string {
+	return ac.command
+}
+
 func (ac *ArgConsumer) Consume(arg int, argDescription string) string {
 	if len(ac.positionalArgs) < arg+1 || ac.positionalArgs[arg] == "" {
 		ac.diagnose(fmt.Sprintf("Incorrect usage: %s not specified.", argDescription), ac.command)
@@ -50,6 +55,15 @@ func (ac *ArgConsumer) Consume(arg int, argDescription string) string {
 	return ac.positionalArgs[arg]
 }
 
+// ConsumeOptional is like Consume but returns the empty string if the argument was not specified.
+func (ac *ArgConsumer) ConsumeOptional(arg int) string {
+	if len(ac.positionalArgs) < arg+1 {
+		return ""
+	}
+	ac.consumed[arg] = struct{}{}
+	return ac.positionalArgs[arg]
+}
+
 func (ac *ArgConsumer) CheckAllConsumed() {
 	if len(ac.consumed) < len(ac.positionalArgs) {
 		extra := []string{}
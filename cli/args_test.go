@@ -34,6 +34,11 @@ var _ = Describe("ArgConsumer", func() {
 		})
 	})
 
+	It("should return the command", func() {
+		argConsumer = cli.NewArgConsumer([]string{"command", "arg"}, func(message string, command string) {})
+		Expect(argConsumer.Command()).To(Equal("command"))
+	})
+
 	Context("when there is at least one argument", func() {
 		var (
 			args               []string
@@ -105,6 +110,33 @@ var _ = Describe("ArgConsumer", func() {
 				})
 			})
 
+			Context("when the second argument is consumed optionally", func() {
+				var arg string
+
+				BeforeEach(func() {
+					arg = argConsumer.ConsumeOptional(1)
+				})
+
+				It("should return the argument", func() {
+					Expect(arg).To(Equal("arg2"))
+					Expect(diagnoseCallCount).To(Equal(0))
+				})
+			})
+
+			Context("when a third argument is consumed optionally", func() {
+				var arg string
+
+				BeforeEach(func() {
+					argConsumer.Consume(1, "second argument")
+					arg = argConsumer.ConsumeOptional(2)
+				})
+
+				It("should return the empty string without failing", func() {
+					Expect(arg).To(BeEmpty())
+					Expect(diagnoseCallCount).To(Equal(0))
+				})
+			})
+
 			Context("when an attempt is made to consume a third argument", func() {
 				BeforeEach(func() {
 					argConsumer.Consume(1, "second argument")
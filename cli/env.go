@@ -0,0 +1,50 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// DurationFromEnvironment returns the value of the given environment variable, either as a duration, such as "90s",
+// or as a whole number of seconds. If the variable is not set or is empty, the given default is returned. An invalid
+// or negative value is reported, using the given example of a duration.
+func DurationFromEnvironment(envVar string, defaultValue time.Duration, example string) (time.Duration, error) {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := parseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid value '%s' of %s: expected a duration such as %s or a number of seconds", value, envVar, example)
+	}
+	return duration, nil
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err == nil && duration < 0 {
+		return 0, fmt.Errorf("negative duration %s", value)
+	}
+	return duration, err
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli_test
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+)
+
+var _ = Describe("DurationFromEnvironment", func() {
+	const envVar = "SCDF_TEST_DURATION"
+
+	var (
+		duration time.Duration
+		err      error
+	)
+
+	AfterEach(func() {
+		os.Unsetenv(envVar)
+	})
+
+	JustBeforeEach(func() {
+		duration, err = cli.DurationFromEnvironment(envVar, time.Minute, "90s")
+	})
+
+	Context("when the environment variable is not set", func() {
+		It("should return the default", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(Equal(time.Minute))
+		})
+	})
+
+	Context("when the environment variable is a duration", func() {
+		BeforeEach(func() {
+			os.Setenv(envVar, "1h30m")
+		})
+
+		It("should return the duration", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(Equal(90 * time.Minute))
+		})
+	})
+
+	Context("when the environment variable is a number of seconds", func() {
+		BeforeEach(func() {
+			os.Setenv(envVar, "45")
+		})
+
+		It("should return the duration", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(Equal(45 * time.Second))
+		})
+	})
+
+	Context("when the environment variable is zero", func() {
+		BeforeEach(func() {
+			os.Setenv(envVar, "0")
+		})
+
+		It("should return zero", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(duration).To(BeZero())
+		})
+	})
+
+	Context("when the environment variable is negative", func() {
+		BeforeEach(func() {
+			os.Setenv(envVar, "-5s")
+		})
+
+		It("should report the invalid value", func() {
+			Expect(err).To(MatchError("Invalid value '-5s' of SCDF_TEST_DURATION: expected a duration such as 90s or a number of seconds"))
+		})
+	})
+
+	Context("when the environment variable is not a duration", func() {
+		BeforeEach(func() {
+			os.Setenv(envVar, "soon")
+		})
+
+		It("should report the invalid value", func() {
+			Expect(err).To(MatchError("Invalid value 'soon' of SCDF_TEST_DURATION: expected a duration such as 90s or a number of seconds"))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const flagTerminator = "--"
+
+// ParseFlags parses the flags in the given arguments using the given flag set and returns the remaining positional
+// arguments, starting with the command. Unlike flag.FlagSet.Parse, flags may appear before, between, or after
+// positional arguments. If the flags are invalid, the given diagnostic function is called.
+func ParseFlags(args []string, flagSet *flag.FlagSet, diagnose DiagnosticFunc) []string {
+	command := args[0]
+	flagSet.SetOutput(ioutil.Discard)
+
+	positionalArgs := []string{command}
+	remaining := args[1:]
+	for len(remaining) > 0 {
+		if err := flagSet.Parse(remaining); err != nil {
+			diagnose(fmt.Sprintf("Incorrect usage: %s.", err), command)
+			return positionalArgs
+		}
+
+		parsed := len(remaining) - flagSet.NArg()
+		if parsed > 0 && remaining[parsed-1] == flagTerminator {
+			return append(positionalArgs, flagSet.Args()...)
+		}
+
+		remaining = flagSet.Args()
+		if len(remaining) > 0 {
+			positionalArgs = append(positionalArgs, remaining[0])
+			remaining = remaining[1:]
+		}
+	}
+	return positionalArgs
+}
+
+// StringsFlag is a flag which may be specified more than once, each time with a value which is appended to the
+// previous values.
+type StringsFlag []string
+
+func (s *StringsFlag) String() string {
+	return strings.Join(*s, " ")
+}
+
+func (s *StringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli_test
+
+import (
+	"flag"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+)
+
+var _ = Describe("ParseFlags", func() {
+	var (
+		args               []string
+		flagSet            *flag.FlagSet
+		name               *string
+		force              *bool
+		positionalArgs     []string
+		diagnoseCallCount  int
+		diagnoseMessageArg string
+		diagnoseCommandArg string
+	)
+
+	BeforeEach(func() {
+		diagnoseCallCount = 0
+		diagnoseMessageArg = ""
+		diagnoseCommandArg = ""
+		flagSet = flag.NewFlagSet("command", flag.ContinueOnError)
+		name = flagSet.String("name", "", "a name")
+		force = flagSet.Bool("force", false, "force")
+	})
+
+	JustBeforeEach(func() {
+		positionalArgs = cli.ParseFlags(args, flagSet, func(message string, command string) {
+			diagnoseCallCount++
+			diagnoseMessageArg = message
+			diagnoseCommandArg = command
+		})
+	})
+
+	Context("when there are no flags", func() {
+		BeforeEach(func() {
+			args = []string{"command", "a", "b"}
+		})
+
+		It("should return all the arguments", func() {
+			Expect(diagnoseCallCount).To(Equal(0))
+			Expect(positionalArgs).To(Equal([]string{"command", "a", "b"}))
+			Expect(*name).To(BeEmpty())
+			Expect(*force).To(BeFalse())
+		})
+	})
+
+	Context("when flags precede the positional arguments", func() {
+		BeforeEach(func() {
+			args = []string{"command", "--name", "x", "--force", "a"}
+		})
+
+		It("should parse the flags and return the positional arguments", func() {
+			Expect(diagnoseCallCount).To(Equal(0))
+			Expect(positionalArgs).To(Equal([]string{"command", "a"}))
+			Expect(*name).To(Equal("x"))
+			Expect(*force).To(BeTrue())
+		})
+	})
+
+	Context("when flags are interleaved with positional arguments", func() {
+		BeforeEach(func() {
+			args = []string{"command", "a", "--name=x", "b", "-force"}
+		})
+
+		It("should parse the flags and return the positional arguments", func() {
+			Expect(diagnoseCallCount).To(Equal(0))
+			Expect(positionalArgs).To(Equal([]string{"command", "a", "b"}))
+			Expect(*name).To(Equal("x"))
+			Expect(*force).To(BeTrue())
+		})
+	})
+
+	Context("when the flag terminator is used", func() {
+		BeforeEach(func() {
+			args = []string{"command", "a", "--", "--force", "b"}
+		})
+
+		It("should treat subsequent arguments as positional", func() {
+			Expect(diagnoseCallCount).To(Equal(0))
+			Expect(positionalArgs).To(Equal([]string{"command", "a", "--force", "b"}))
+			Expect(*force).To(BeFalse())
+		})
+	})
+
+	Context("when an unknown flag is used", func() {
+		BeforeEach(func() {
+			args = []string{"command", "a", "--unknown"}
+		})
+
+		It("should diagnose the problem", func() {
+			Expect(diagnoseCallCount).To(Equal(1))
+			Expect(diagnoseCommandArg).To(Equal("command"))
+			Expect(diagnoseMessageArg).To(Equal("Incorrect usage: flag provided but not defined: -unknown."))
+		})
+	})
+
+	Context("when a flag value is missing", func() {
+		BeforeEach(func() {
+			args = []string{"command", "--name"}
+		})
+
+		It("should diagnose the problem", func() {
+			Expect(diagnoseCallCount).To(Equal(1))
+			Expect(diagnoseMessageArg).To(Equal("Incorrect usage: flag needs an argument: -name."))
+		})
+	})
+})
+
+var _ = Describe("StringsFlag", func() {
+	It("should accumulate values in order", func() {
+		var values cli.StringsFlag
+		flagSet := flag.NewFlagSet("command", flag.ContinueOnError)
+		flagSet.Var(&values, "arg", "")
+		cli.ParseFlags([]string{"command", "--arg", "--a=b", "x", "--arg=c d"}, flagSet, func(message string, command string) {
+			Fail(message)
+		})
+
+		Expect([]string(values)).To(Equal([]string{"--a=b", "c d"}))
+		Expect(values.String()).To(Equal("--a=b c d"))
+	})
+})
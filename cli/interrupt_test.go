@@ -0,0 +1,50 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+)
+
+var _ = Describe("InterruptibleContext", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = cli.InterruptibleContext()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should not be cancelled initially", func() {
+		Consistently(ctx.Done(), 100*time.Millisecond).ShouldNot(BeClosed())
+	})
+
+	It("should be cancelled by the cancel function", func() {
+		cancel()
+		Expect(ctx.Done()).To(BeClosed())
+	})
+})
@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// PropertiesFlag is a flag which may be specified more than once, each time with a property of the form key=value.
+type PropertiesFlag map[string]string
+
+func (p PropertiesFlag) String() string {
+	keys := make([]string, 0, len(p))
+	for key := range p {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	properties := make([]string, 0, len(keys))
+	for _, key := range keys {
+		properties = append(properties, key+"="+p[key])
+	}
+	return strings.Join(properties, ",")
+}
+
+func (p PropertiesFlag) Set(value string) error {
+	key, propertyValue, ok := splitProperty(value, "=")
+	if !ok {
+		return fmt.Errorf("invalid property '%s': expected key=value", value)
+	}
+	p[key] = propertyValue
+	return nil
+}
+
+// ReadPropertiesFile reads properties from the given file, which uses the format of Java properties files: each line
+// holds a key and value separated by '=' or ':', and lines starting with '#' or '!' are comments.
+func ReadPropertiesFile(path string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read properties file %s: %s", path, err)
+	}
+	return ParseProperties(string(content), path)
+}
+
+// ParseProperties parses properties in the format of Java properties files, as for ReadPropertiesFile. The source
+// names the properties in any error message. Line continuations and escape sequences are not supported.
+func ParseProperties(content string, source string) (map[string]string, error) {
+	properties := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		key, value, ok := splitProperty(line, "=:")
+		if !ok {
+			return nil, fmt.Errorf("Invalid property at line %d of %s: expected key=value", lineNumber, source)
+		}
+		properties[key] = value
+	}
+	return properties, scanner.Err()
+}
+
+// MergeProperties returns the properties in the given maps, with properties in later maps replacing those with the
+// same keys in earlier maps.
+func MergeProperties(maps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, properties := range maps {
+		for key, value := range properties {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// splitProperty splits the given property at the first of the given separators, trimming white space around the key
+// and value. The key must not be empty.
+func splitProperty(property string, separators string) (string, string, bool) {
+	i := strings.IndexAny(property, separators)
+	if i < 0 {
+		return "", "", false
+	}
+	key := strings.TrimSpace(property[:i])
+	if key == "" {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(property[i+1:]), true
+}
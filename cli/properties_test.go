@@ -0,0 +1,101 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+)
+
+var _ = Describe("Properties", func() {
+	Describe("PropertiesFlag", func() {
+		var (
+			flagSet    *flag.FlagSet
+			properties cli.PropertiesFlag
+		)
+
+		BeforeEach(func() {
+			flagSet = flag.NewFlagSet("command", flag.ContinueOnError)
+			properties = cli.PropertiesFlag{}
+			flagSet.Var(properties, "properties", "")
+		})
+
+		It("should accumulate properties", func() {
+			Expect(flagSet.Parse([]string{"--properties", "app.log.count=2", "--properties", "deployer.*.memory = 1g"})).To(Succeed())
+			Expect(map[string]string(properties)).To(Equal(map[string]string{"app.log.count": "2", "deployer.*.memory": "1g"}))
+			Expect(properties.String()).To(Equal("app.log.count=2,deployer.*.memory=1g"))
+		})
+
+		It("should allow values containing separators", func() {
+			Expect(flagSet.Parse([]string{"--properties", "app.http.uri=http://example.com/a=b,c"})).To(Succeed())
+			Expect(properties["app.http.uri"]).To(Equal("http://example.com/a=b,c"))
+		})
+
+		It("should reject a property without a key", func() {
+			flagSet.SetOutput(ioutil.Discard)
+			Expect(flagSet.Parse([]string{"--properties", "=2"})).To(MatchError(ContainSubstring("invalid property '=2': expected key=value")))
+		})
+	})
+
+	Describe("ReadPropertiesFile", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "properties")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("should read properties, skipping comments and blank lines", func() {
+			path := filepath.Join(dir, "deploy.properties")
+			Expect(ioutil.WriteFile(path, []byte("# Deployment\n\napp.log.count=2\n! legacy comment\ndeployer.log.memory: 1g\n"), 0644)).To(Succeed())
+
+			properties, err := cli.ReadPropertiesFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(properties).To(Equal(map[string]string{"app.log.count": "2", "deployer.log.memory": "1g"}))
+		})
+
+		It("should report an invalid line", func() {
+			path := filepath.Join(dir, "deploy.properties")
+			Expect(ioutil.WriteFile(path, []byte("a=1\nnonsense\n"), 0644)).To(Succeed())
+
+			_, err := cli.ReadPropertiesFile(path)
+			Expect(err).To(MatchError("Invalid property at line 2 of " + path + ": expected key=value"))
+		})
+
+		It("should report a missing file", func() {
+			_, err := cli.ReadPropertiesFile(filepath.Join(dir, "missing"))
+			Expect(err).To(MatchError(HavePrefix("Cannot read properties file")))
+		})
+	})
+
+	Describe("MergeProperties", func() {
+		It("should let later properties replace earlier ones", func() {
+			Expect(cli.MergeProperties(map[string]string{"a": "1", "b": "2"}, nil, map[string]string{"b": "3"})).To(Equal(map[string]string{"a": "1", "b": "3"}))
+		})
+	})
+})
@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/skipper"
+)
+
+// aboutParallelism bounds the number of dataflow servers which are queried at once.
+const aboutParallelism = 8
+
+// serviceStatus is the status of a dataflow service instance as listed by DataflowServices.
+type serviceStatus struct {
+	serviceutil.ServiceInstanceSummary
+	key             serviceutil.ServerKey
+	server          *serviceutil.CachedServer
+	dataflowVersion string
+	skipperVersion  string
+	status          string
+}
+
+// DataflowServices lists the dataflow service instances in the targeted space or, if allSpaces is true, in all the
+// spaces visible to the user. The server URLs of each service instance are resolved, using the given cache, and the
+// dataflow servers are queried concurrently for their versions and those of their Skipper servers.
+func DataflowServices(ctx context.Context, cliConnection plugin.CliConnection, serverCache serviceutil.ServerCache, authClient httpclient.AuthenticatedClient, accessToken string, allSpaces bool) (string, error) {
+	summaries, err := serviceutil.ListDataflowServiceInstances(cliConnection, allSpaces)
+	if err != nil {
+		return "", err
+	}
+	if len(summaries) == 0 {
+		return "No dataflow service instances found\n", nil
+	}
+
+	// The cf CLI is not called concurrently, so server URLs are resolved before the servers are queried.
+	statuses := make([]*serviceStatus, len(summaries))
+	for i, summary := range summaries {
+		status := &serviceStatus{ServiceInstanceSummary: summary}
+		statuses[i] = status
+		key, err := serviceutil.ServerKeyFor(cliConnection, summary.Org, summary.Space, summary.Name)
+		if err != nil {
+			return "", err
+		}
+		status.key = key
+		status.server, err = serviceutil.ResolveServiceInstanceServer(ctx, cliConnection, serverCache, key, &summary.ServiceInstance, accessToken, authClient)
+		if err != nil {
+			status.status = fmt.Sprintf("unresolved: %s", err)
+		}
+	}
+
+	pluginutil.ForEachParallel(ctx, len(statuses), aboutParallelism, func(i int) {
+		if statuses[i].server != nil {
+			statuses[i].query(ctx, authClient, accessToken)
+		}
+	})
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	for _, status := range statuses {
+		if status.server == nil {
+			continue
+		}
+		if status.server.About == nil {
+			_ = serverCache.Invalidate(status.key)
+		} else {
+			_ = serverCache.Put(status.key, *status.server)
+		}
+	}
+
+	return servicesTable(statuses, allSpaces), nil
+}
+
+// query fetches the dataflow server's /about resource and determines the versions of the dataflow and Skipper servers.
+// The about resource is retained, so that it may be cached, only if the dataflow server is reachable.
+func (s *serviceStatus) query(ctx context.Context, authClient httpclient.AuthenticatedClient, accessToken string) {
+	body, err := dataflow.FetchAbout(ctx, s.server.DataflowUrl, authClient, accessToken)
+	if err != nil {
+		s.server.About = nil
+		s.status = fmt.Sprintf("unreachable: %s", err)
+		return
+	}
+
+	var about dataflow.AboutInfo
+	if err := json.Unmarshal(body, &about); err != nil {
+		s.server.About = nil
+		s.status = fmt.Sprintf("unreachable: invalid dataflow server response: %s", err)
+		return
+	}
+	s.server.About = body
+	s.status = "reachable"
+	s.dataflowVersion = about.VersionInfo.Core.Version
+
+	appDeployer := about.RuntimeEnvironment.AppDeployer
+	if strings.Contains(appDeployer.DeployerName, "Skipper") {
+		s.skipperVersion = appDeployer.DeployerImplementationVersion
+	} else if s.server.SkipperUrl != "" {
+		if skipperAbout, err := skipper.NewClient(authClient, s.server.SkipperUrl, accessToken).About(ctx); err == nil {
+			s.skipperVersion = skipperAbout.VersionInfo.Server.Version
+		} else {
+			s.skipperVersion = "unreachable"
+		}
+	}
+}
+
+func servicesTable(statuses []*serviceStatus, allSpaces bool) string {
+	headings := []string{"name", "plan", "last operation", "url", "dataflow version", "skipper version", "status"}
+	if allSpaces {
+		headings = append([]string{"org", "space"}, headings...)
+	}
+
+	rows := make([][]string, 0, len(statuses))
+	for _, status := range statuses {
+		url := ""
+		if status.server != nil {
+			url = status.server.DataflowUrl
+		}
+		row := []string{status.Name, status.Plan, status.LastOperation, url, status.dataflowVersion, status.skipperVersion, status.status}
+		if allSpaces {
+			row = append([]string{status.Org, status.Space}, row...)
+		}
+		rows = append(rows, row)
+	}
+	return format.Table(headings, rows)
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil/serviceutilfakes"
+)
+
+var _ = Describe("DataflowServices", func() {
+	const (
+		planQuery     = "?q=service_plan_guid+IN+plan-guid"
+		instancesPath = "/v2/spaces/space-guid/service_instances" + planQuery
+		accessToken   = "access-token"
+	)
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		fakeAuthClient    *httpclientfakes.FakeAuthenticatedClient
+		fakeServerCache   *serviceutilfakes.FakeServerCache
+		curlOutput        map[string][]string
+		responses         map[string]string
+		allSpaces         bool
+		output            string
+		err               error
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.ApiEndpointReturns("https://api.example.com", nil)
+		fakeCliConnection.UsernameReturns("user", nil)
+		fakeCliConnection.GetCurrentOrgReturns(plugin_models.Organization{OrganizationFields: plugin_models.OrganizationFields{Name: "org", Guid: "org-guid"}}, nil)
+		fakeCliConnection.GetCurrentSpaceReturns(plugin_models.Space{SpaceFields: plugin_models.SpaceFields{Name: "space", Guid: "space-guid"}}, nil)
+		curlOutput = map[string][]string{
+			"/v2/services?q=label%3Ap-dataflow":       {`{"resources": [{"metadata": {"guid": "service-guid"}}]}`},
+			"/v2/services/service-guid/service_plans": {`{"resources": [{"metadata": {"guid": "plan-guid"}, "entity": {"name": "standard"}}]}`},
+			instancesPath: {`{"resources": [`,
+				`{"metadata": {"guid": "guid-a"}, "entity": {"name": "a", "service_plan_guid": "plan-guid", "space_guid": "space-guid", "last_operation": {"type": "create", "state": "succeeded"}}},`,
+				`{"metadata": {"guid": "guid-b"}, "entity": {"name": "b", "service_plan_guid": "plan-guid", "space_guid": "space-guid", "last_operation": {"type": "update", "state": "failed"}}}]}`},
+			"/v2/service_instances/guid-a/service_keys": {`{"resources": [{"entity": {"credentials": {"dataflow-url": "https://dataflow-a.example.com", "skipper-api": "https://skipper-a.example.com/api"}}}]}`},
+			"/v2/service_instances/guid-b/service_keys": {`{"resources": [{"entity": {"credentials": {"dataflow-url": "https://dataflow-b.example.com"}}}]}`},
+		}
+		fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+			output, ok := curlOutput[args[1]]
+			if !ok {
+				return nil, errors.New("unexpected request " + args[1])
+			}
+			return output, nil
+		}
+
+		responses = map[string]string{
+			"https://dataflow-a.example.com/about": `{"versionInfo": {"core": {"version": "2.1.0.RELEASE"}},
+				"runtimeEnvironment": {"appDeployer": {"deployerName": "Spring Cloud Skipper Server", "deployerImplementationVersion": "2.0.1.RELEASE"}}}`,
+		}
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedGetContextStub = func(ctx context.Context, url string, token string) (io.ReadCloser, int, http.Header, error) {
+			body, ok := responses[url]
+			if !ok {
+				return nil, 0, nil, errors.New("connection refused")
+			}
+			return ioutil.NopCloser(strings.NewReader(body)), http.StatusOK, nil, nil
+		}
+		fakeAuthClient.DoAuthenticatedStub = func(ctx context.Context, request *httpclient.Request, token string) (*http.Response, error) {
+			body, ok := responses[request.URL()]
+			if !ok {
+				return nil, errors.New("connection refused")
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		fakeServerCache = &serviceutilfakes.FakeServerCache{}
+		allSpaces = false
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.DataflowServices(context.Background(), fakeCliConnection, fakeServerCache, fakeAuthClient, accessToken, allSpaces)
+	})
+
+	It("should list the service instances with their status", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(
+			"name   plan       last operation     url                              dataflow version   skipper version   status\n" +
+				"a      standard   create succeeded   https://dataflow-a.example.com   2.1.0.RELEASE      2.0.1.RELEASE     reachable\n" +
+				"b      standard   update failed      https://dataflow-b.example.com                                        unreachable: Dataflow server error: connection refused\n"))
+	})
+
+	It("should cache reachable servers and invalidate unreachable ones", func() {
+		Expect(fakeServerCache.PutCallCount()).To(Equal(3))
+		key, server := fakeServerCache.PutArgsForCall(2)
+		Expect(key).To(Equal(serviceutil.ServerKey{Api: "https://api.example.com", User: "user", Org: "org", Space: "space", ServiceInstance: "a"}))
+		Expect(server.About).NotTo(BeNil())
+
+		Expect(fakeServerCache.InvalidateCallCount()).To(Equal(1))
+		Expect(fakeServerCache.InvalidateArgsForCall(0).ServiceInstance).To(Equal("b"))
+	})
+
+	Context("when the dataflow server does not deploy streams using Skipper", func() {
+		BeforeEach(func() {
+			responses["https://dataflow-a.example.com/about"] = `{"versionInfo": {"core": {"version": "1.7.0.RELEASE"}},
+				"runtimeEnvironment": {"appDeployer": {"deployerName": "cloudfoundry"}}}`
+			responses["https://skipper-a.example.com/api/"] = `{"_links": {}}`
+			responses["https://skipper-a.example.com/api/about"] = `{"versionInfo": {"server": {"version": "1.1.0.RELEASE"}}}`
+		})
+
+		It("should ask the Skipper server for its version", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(ContainSubstring("1.7.0.RELEASE      1.1.0.RELEASE"))
+		})
+	})
+
+	Context("when the server URLs cannot be resolved", func() {
+		BeforeEach(func() {
+			delete(curlOutput, "/v2/service_instances/guid-b/service_keys")
+		})
+
+		It("should report the service instance as unresolved", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(MatchRegexp(`\nb .* unresolved: `))
+		})
+	})
+
+	Context("when all spaces are listed", func() {
+		BeforeEach(func() {
+			allSpaces = true
+			curlOutput["/v2/service_instances"+planQuery] = curlOutput[instancesPath]
+			curlOutput["/v2/spaces/space-guid"] = []string{`{"entity": {"name": "space", "organization_guid": "org-guid"}}`}
+			curlOutput["/v2/organizations/org-guid"] = []string{`{"entity": {"name": "org"}}`}
+		})
+
+		It("should include the org and space of each service instance", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(HavePrefix("org   space   name   plan"))
+			Expect(output).To(ContainSubstring("\norg   space   a      standard"))
+		})
+	})
+
+	Context("when there are no service instances", func() {
+		BeforeEach(func() {
+			curlOutput[instancesPath] = []string{`{"resources": []}`}
+		})
+
+		It("should say so", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("No dataflow service instances found\n"))
+		})
+	})
+})
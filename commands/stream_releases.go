@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+// StreamHistory lists the releases of the stream with the given name, most recent first, with their status and date.
+// If changes is true, the property changes made by each release are shown.
+func StreamHistory(ctx context.Context, client dataflow.Client, name string, changes bool) (string, error) {
+	releases, err := client.StreamHistory(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if len(releases) == 0 {
+		return fmt.Sprintf("Stream %s has no releases\n", name), nil
+	}
+
+	rows := make([][]string, 0, len(releases))
+	for _, release := range releases {
+		rows = append(rows, []string{strconv.Itoa(release.Version), release.Info.Status.StatusCode, format.Time(release.Info.LastDeployed.Time), release.Info.Description})
+	}
+	output := format.Table([]string{"version", "status", "date", "description"}, rows)
+	if !changes {
+		return output, nil
+	}
+
+	properties := make([]map[string]string, len(releases))
+	for i, release := range releases {
+		if properties[i], err = releaseProperties(ctx, client, name, release.Version); err != nil {
+			return "", err
+		}
+	}
+	for i := range releases {
+		previous := map[string]string{}
+		if i+1 < len(releases) {
+			previous = properties[i+1]
+		}
+		output += fmt.Sprintf("\nVersion %d:\n%s", releases[i].Version, propertyChanges(previous, properties[i]))
+	}
+	return output, nil
+}
+
+// UpdateStream shows how updating the stream with the given name using the given deployment properties would change
+// the properties of its current release and, unless dryRun is true, updates the stream. The version of the current
+// release is returned so that the new release can be waited for.
+func UpdateStream(ctx context.Context, client dataflow.Client, name string, properties map[string]string, dryRun bool) (int, string, error) {
+	current, err := currentRelease(ctx, client, name)
+	if err != nil {
+		return 0, "", err
+	}
+	currentProperties, err := releaseProperties(ctx, client, name, current.Version)
+	if err != nil {
+		return 0, "", err
+	}
+
+	output := fmt.Sprintf("Property changes to version %d:\n%s", current.Version, propertyChanges(currentProperties, cli.MergeProperties(currentProperties, properties)))
+	if dryRun {
+		return current.Version, output, nil
+	}
+	if err := client.UpdateStream(ctx, name, properties); err != nil {
+		return 0, "", err
+	}
+	return current.Version, output + fmt.Sprintf("\nUpdate of stream %s requested\n", name), nil
+}
+
+// RollbackStream shows how rolling back the stream with the given name to the given release version, or to the
+// previous release if version is zero, would change the properties of its current release and, unless dryRun is true,
+// rolls back the stream.
+func RollbackStream(ctx context.Context, client dataflow.Client, name string, version int, dryRun bool) (string, error) {
+	releases, err := client.StreamHistory(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if len(releases) == 0 {
+		return "", fmt.Errorf("Stream %s has no releases", name)
+	}
+	current := releases[0]
+
+	if version == 0 {
+		if len(releases) < 2 {
+			return "", fmt.Errorf("Stream %s has no previous release to roll back to", name)
+		}
+		version = releases[1].Version
+	} else if !hasRelease(releases, version) {
+		return "", fmt.Errorf("Stream %s has no release with version %d", name, version)
+	}
+
+	currentProperties, err := releaseProperties(ctx, client, name, current.Version)
+	if err != nil {
+		return "", err
+	}
+	targetProperties, err := releaseProperties(ctx, client, name, version)
+	if err != nil {
+		return "", err
+	}
+
+	output := fmt.Sprintf("Property changes from version %d to version %d:\n%s", current.Version, version, propertyChanges(currentProperties, targetProperties))
+	if dryRun {
+		return output, nil
+	}
+	if err := client.RollbackStream(ctx, name, version); err != nil {
+		return "", err
+	}
+	return output + fmt.Sprintf("\nRollback of stream %s to version %d requested\n", name, version), nil
+}
+
+func currentRelease(ctx context.Context, client dataflow.Client, name string) (*dataflow.StreamRelease, error) {
+	releases, err := client.StreamHistory(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("Stream %s has no releases", name)
+	}
+	return &releases[0], nil
+}
+
+func hasRelease(releases []dataflow.StreamRelease, version int) bool {
+	for _, release := range releases {
+		if release.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseProperties returns the properties of the given release version of the stream with the given name.
+func releaseProperties(ctx context.Context, client dataflow.Client, name string, version int) (map[string]string, error) {
+	manifest, err := client.StreamManifest(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+	return dataflow.ManifestProperties(manifest)
+}
+
+// propertyChanges describes the properties which are added, removed, or changed in going from the given old
+// properties to the given new properties, one per line sorted by key.
+func propertyChanges(oldProperties map[string]string, newProperties map[string]string) string {
+	keys := []string{}
+	for key := range cli.MergeProperties(oldProperties, newProperties) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes strings.Builder
+	for _, key := range keys {
+		oldValue, inOld := oldProperties[key]
+		newValue, inNew := newProperties[key]
+		switch {
+		case !inOld:
+			changes.WriteString(format.Green("+ %s=%s", key, newValue) + "\n")
+		case !inNew:
+			changes.WriteString(format.Red("- %s=%s", key, oldValue) + "\n")
+		case oldValue != newValue:
+			changes.WriteString(fmt.Sprintf("~ %s: %s -> %s\n", key, oldValue, newValue))
+		}
+	}
+	if changes.Len() == 0 {
+		return "No property changes\n"
+	}
+	return changes.String()
+}
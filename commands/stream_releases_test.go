@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+var _ = Describe("Stream release commands", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+		deployed   time.Time
+	)
+
+	manifest := func(count int, level string) string {
+		return fmt.Sprintf(`---
+kind: SpringCloudDeployerApplication
+metadata:
+  name: log
+spec:
+  version: 2.1.0.RELEASE
+  applicationProperties:
+    log.level: %s
+  deploymentProperties:
+    spring.cloud.deployer.count: %d
+`, level, count)
+	}
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		deployed = time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)
+
+		release := func(version int, status string) dataflow.StreamRelease {
+			release := dataflow.StreamRelease{Name: "ticktock", Version: version}
+			release.Info.Status.StatusCode = status
+			release.Info.LastDeployed.Time = deployed
+			release.Info.Description = fmt.Sprintf("Release %d", version)
+			return release
+		}
+		fakeClient = &dataflowfakes.FakeClient{}
+		fakeClient.StreamHistoryReturns([]dataflow.StreamRelease{release(3, "DEPLOYED"), release(2, "DELETED"), release(1, "DELETED")}, nil)
+		fakeClient.StreamManifestStub = func(ctx context.Context, name string, version int) (string, error) {
+			switch version {
+			case 1:
+				return manifest(1, "INFO"), nil
+			case 2:
+				return manifest(2, "INFO"), nil
+			case 3:
+				return manifest(2, "DEBUG"), nil
+			}
+			return "", errors.New("no such version")
+		}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("StreamHistory", func() {
+		It("should list the releases", func() {
+			output, err := commands.StreamHistory(ctx, fakeClient, "ticktock", false)
+			Expect(err).NotTo(HaveOccurred())
+			date := format.Time(deployed)
+			heading := "date" + strings.Repeat(" ", len(date)-len("date"))
+			Expect(output).To(Equal("version   status     " + heading + "   description\n" +
+				"3         DEPLOYED   " + date + "   Release 3\n" +
+				"2         DELETED    " + date + "   Release 2\n" +
+				"1         DELETED    " + date + "   Release 1\n"))
+			Expect(fakeClient.StreamManifestCallCount()).To(Equal(0))
+		})
+
+		It("should show the property changes of each release", func() {
+			output, err := commands.StreamHistory(ctx, fakeClient, "ticktock", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(HaveSuffix("\nVersion 3:\n" +
+				"~ app.log.log.level: INFO -> DEBUG\n" +
+				"\nVersion 2:\n" +
+				"~ deployer.log.count: 1 -> 2\n" +
+				"\nVersion 1:\n" +
+				"+ app.log.log.level=INFO\n" +
+				"+ deployer.log.count=1\n" +
+				"+ version.log=2.1.0.RELEASE\n"))
+		})
+
+		It("should say when there are no releases", func() {
+			fakeClient.StreamHistoryReturns([]dataflow.StreamRelease{}, nil)
+			output, err := commands.StreamHistory(ctx, fakeClient, "ticktock", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock has no releases\n"))
+		})
+	})
+
+	Describe("UpdateStream", func() {
+		It("should show the property changes and update the stream", func() {
+			version, output, err := commands.UpdateStream(ctx, fakeClient, "ticktock", map[string]string{"deployer.log.count": "3", "app.log.log.level": "DEBUG", "deployer.log.memory": "1g"}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal(3))
+			Expect(output).To(Equal("Property changes to version 3:\n" +
+				"~ deployer.log.count: 2 -> 3\n" +
+				"+ deployer.log.memory=1g\n" +
+				"\nUpdate of stream ticktock requested\n"))
+
+			Expect(fakeClient.UpdateStreamCallCount()).To(Equal(1))
+			_, name, properties := fakeClient.UpdateStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+			Expect(properties).To(HaveKeyWithValue("deployer.log.count", "3"))
+		})
+
+		It("should not update the stream in a dry run", func() {
+			_, output, err := commands.UpdateStream(ctx, fakeClient, "ticktock", map[string]string{"app.log.log.level": "DEBUG"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Property changes to version 3:\nNo property changes\n"))
+			Expect(fakeClient.UpdateStreamCallCount()).To(Equal(0))
+		})
+
+		It("should fail if the stream has not been deployed", func() {
+			fakeClient.StreamHistoryReturns([]dataflow.StreamRelease{}, nil)
+			_, _, err := commands.UpdateStream(ctx, fakeClient, "ticktock", nil, false)
+			Expect(err).To(MatchError("Stream ticktock has no releases"))
+		})
+	})
+
+	Describe("RollbackStream", func() {
+		It("should roll back to the previous release by default", func() {
+			output, err := commands.RollbackStream(ctx, fakeClient, "ticktock", 0, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Property changes from version 3 to version 2:\n" +
+				"~ app.log.log.level: DEBUG -> INFO\n" +
+				"\nRollback of stream ticktock to version 2 requested\n"))
+
+			_, name, version := fakeClient.RollbackStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+			Expect(version).To(Equal(2))
+		})
+
+		It("should roll back to the given version", func() {
+			output, err := commands.RollbackStream(ctx, fakeClient, "ticktock", 1, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Property changes from version 3 to version 1:\n" +
+				"~ app.log.log.level: DEBUG -> INFO\n" +
+				"~ deployer.log.count: 2 -> 1\n"))
+			Expect(fakeClient.RollbackStreamCallCount()).To(Equal(0))
+		})
+
+		It("should reject an unknown version", func() {
+			_, err := commands.RollbackStream(ctx, fakeClient, "ticktock", 7, false)
+			Expect(err).To(MatchError("Stream ticktock has no release with version 7"))
+		})
+
+		It("should fail if there is no previous release", func() {
+			fakeClient.StreamHistoryReturns([]dataflow.StreamRelease{{Name: "ticktock", Version: 1}}, nil)
+			_, err := commands.RollbackStream(ctx, fakeClient, "ticktock", 0, false)
+			Expect(err).To(MatchError("Stream ticktock has no previous release to roll back to"))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+// ScaleStreamApp scales the application with the given label in the stream with the given name to the given number of
+// instances, applying the given deployment properties. The label is checked against the stream's definition first.
+func ScaleStreamApp(ctx context.Context, client dataflow.Client, name string, appLabel string, count int, properties map[string]string) (string, error) {
+	stream, err := client.StreamDefinition(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	labels := stream.AppLabels()
+	if !containsString(labels, appLabel) {
+		return "", fmt.Errorf("Stream %s has no application labelled %s. Applications: %s", name, appLabel, strings.Join(labels, ", "))
+	}
+
+	if err := client.ScaleStreamApp(ctx, name, appLabel, count, properties); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Scaling of application %s of stream %s to %d %s requested\n", appLabel, name, count, pluralInstances(count)), nil
+}
+
+// WaitForStreamAppScale waits, polling at the given interval, until the application with the given label in the stream
+// with the given name has the given number of instances, all deployed, writing changes to the instances to the given
+// writer as they are seen. If the application fails, the error is an *ExitError with code ExitFailed. If the
+// application is not scaled within the given timeout, the error is an *ExitError with code ExitTimeout.
+func WaitForStreamAppScale(ctx context.Context, client dataflow.Client, name string, appLabel string, count int, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	lastSummary := ""
+	err := pluginutil.Poll(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		statuses, err := client.StreamRuntimeStatus(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		app := findApp(statuses, appLabel)
+		instances := []dataflow.AppInstanceStatus{}
+		state := dataflow.StateUndeployed
+		if app != nil {
+			instances = app.AppInstances()
+			state = app.State
+		}
+
+		summary := instanceSummary(instances)
+		if summary != lastSummary {
+			fmt.Fprintf(progressWriter, "Application %s is %s (%s)\n", format.Bold(format.Cyan(appLabel)), state, summary)
+			lastSummary = summary
+		}
+		if isFailed(state) {
+			return false, &ExitError{Code: ExitFailed, Err: fmt.Errorf("Scaling of stream %s failed: application %s is %s", name, appLabel, state)}
+		}
+		return len(instances) == count && deployedInstances(instances) == count, nil
+	})
+
+	var timeoutError *pluginutil.TimeoutError
+	if errors.As(err, &timeoutError) {
+		return "", &ExitError{
+			Code: ExitTimeout,
+			Err:  fmt.Errorf("%w waiting for application %s of stream %s to be scaled to %d %s. Instances: %s", err, appLabel, name, count, pluralInstances(count), lastSummary),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Application %s of stream %s scaled to %d %s\n", appLabel, name, count, pluralInstances(count)), nil
+}
+
+// findApp returns the runtime status of the application with the given label, or nil if it is not deployed.
+func findApp(statuses []dataflow.StreamStatus, appLabel string) *dataflow.AppStatus {
+	for _, status := range statuses {
+		for _, app := range status.Apps() {
+			if app.Label() == appLabel {
+				return &app
+			}
+		}
+	}
+	return nil
+}
+
+func pluralInstances(count int) string {
+	if count == 1 {
+		return "instance"
+	}
+	return "instances"
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
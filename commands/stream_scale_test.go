@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+)
+
+var _ = Describe("Stream scaling", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("ScaleStreamApp", func() {
+		BeforeEach(func() {
+			fakeClient.StreamDefinitionReturns(&dataflow.StreamDefinition{Name: "ticktock", DslText: "time | logger: log"}, nil)
+		})
+
+		It("should scale the application", func() {
+			output, err := commands.ScaleStreamApp(ctx, fakeClient, "ticktock", "logger", 3, map[string]string{"app.logger.log.level": "DEBUG"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Scaling of application logger of stream ticktock to 3 instances requested\n"))
+
+			Expect(fakeClient.ScaleStreamAppCallCount()).To(Equal(1))
+			_, name, appLabel, count, properties := fakeClient.ScaleStreamAppArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+			Expect(appLabel).To(Equal("logger"))
+			Expect(count).To(Equal(3))
+			Expect(properties).To(Equal(map[string]string{"app.logger.log.level": "DEBUG"}))
+		})
+
+		It("should reject a label which is not in the stream", func() {
+			_, err := commands.ScaleStreamApp(ctx, fakeClient, "ticktock", "log", 1, nil)
+			Expect(err).To(MatchError("Stream ticktock has no application labelled log. Applications: time, logger"))
+			Expect(fakeClient.ScaleStreamAppCallCount()).To(Equal(0))
+		})
+
+		It("should return an error if the stream is not found", func() {
+			fakeClient.StreamDefinitionReturns(nil, errors.New("not found"))
+
+			_, err := commands.ScaleStreamApp(ctx, fakeClient, "ticktock", "logger", 1, nil)
+			Expect(err).To(MatchError("not found"))
+		})
+
+		It("should return an error from scaling", func() {
+			fakeClient.ScaleStreamAppReturns(errors.New("failed"))
+
+			_, err := commands.ScaleStreamApp(ctx, fakeClient, "ticktock", "logger", 1, nil)
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+
+	Describe("WaitForStreamAppScale", func() {
+		var (
+			instanceStates [][]string
+			appState       string
+			progressWriter *bytes.Buffer
+			timeout        time.Duration
+			output         string
+			err            error
+		)
+
+		// runtimeStatus returns the runtime status of a stream whose log application has instances in the given states.
+		runtimeStatus := func(states []string) []dataflow.StreamStatus {
+			instances := []string{}
+			for i, state := range states {
+				instances = append(instances, fmt.Sprintf(`{"instanceId": "ticktock-log-v1-%d", "state": "%s", "attributes": {"skipper.application.name": "logger"}}`, i, state))
+			}
+			var statuses []dataflow.StreamStatus
+			Expect(json.Unmarshal([]byte(`[{"name": "ticktock", "applications": {"_embedded": {"appStatusResourceList": [
+				{"name": "log", "state": "`+appState+`", "instances": {"_embedded": {"appInstanceStatusResourceList": [`+strings.Join(instances, ",")+`]}}}
+			]}}}]`), &statuses)).To(Succeed())
+			return statuses
+		}
+
+		BeforeEach(func() {
+			progressWriter = &bytes.Buffer{}
+			timeout = time.Second
+			appState = "deployed"
+			instanceStates = [][]string{{"deployed"}, {"deployed", "deploying"}, {"deployed", "deployed"}}
+			fakeClient.StreamRuntimeStatusStub = func(ctx context.Context, names ...string) ([]dataflow.StreamStatus, error) {
+				states := instanceStates[0]
+				if len(instanceStates) > 1 {
+					instanceStates = instanceStates[1:]
+				}
+				return runtimeStatus(states), nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			output, err = commands.WaitForStreamAppScale(ctx, fakeClient, "ticktock", "logger", 2, timeout, time.Millisecond, progressWriter)
+		})
+
+		It("should wait for the new instance count to be deployed", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Application logger of stream ticktock scaled to 2 instances\n"))
+			Expect(progressWriter.String()).To(Equal("Application logger is deployed (1/1 deployed)\n" +
+				"Application logger is deployed (1/2 deployed)\n" +
+				"Application logger is deployed (2/2 deployed)\n"))
+		})
+
+		Context("when the application fails", func() {
+			BeforeEach(func() {
+				appState = "failed"
+			})
+
+			It("should fail with the failed exit code", func() {
+				Expect(err).To(MatchError("Scaling of stream ticktock failed: application logger is failed"))
+				var exitError *commands.ExitError
+				Expect(errors.As(err, &exitError)).To(BeTrue())
+				Expect(exitError.Code).To(Equal(commands.ExitFailed))
+			})
+		})
+
+		Context("when the instances are not deployed in time", func() {
+			BeforeEach(func() {
+				timeout = 20 * time.Millisecond
+				instanceStates = [][]string{{"deployed", "deploying"}}
+			})
+
+			It("should fail with the timeout exit code", func() {
+				Expect(err).To(MatchError("Timed out after 20ms waiting for application logger of stream ticktock to be scaled to 2 instances. Instances: 1/2 deployed"))
+				var exitError *commands.ExitError
+				Expect(errors.As(err, &exitError)).To(BeTrue())
+				Expect(exitError.Code).To(Equal(commands.ExitTimeout))
+			})
+		})
+
+		Context("when the application is not deployed", func() {
+			BeforeEach(func() {
+				timeout = 20 * time.Millisecond
+				fakeClient.StreamRuntimeStatusStub = nil
+				fakeClient.StreamRuntimeStatusReturns([]dataflow.StreamStatus{}, nil)
+			})
+
+			It("should report it as undeployed", func() {
+				Expect(progressWriter.String()).To(Equal("Application logger is undeployed (0/0 deployed)\n"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+// WaitForStream waits, polling at the given interval, until the stream with the given name is deployed, writing the
+// state transitions of the stream and its applications to the given writer as they are seen. If the stream or one of
+// its applications fails, the error is an *ExitError with code ExitFailed. If the stream is not deployed within the
+// given timeout, the error is an *ExitError with code ExitPartial, if some of its applications were deployed, or
+// otherwise ExitTimeout.
+func WaitForStream(ctx context.Context, client dataflow.Client, name string, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	return newStreamWaiter(client, name, 0, progressWriter).wait(ctx, timeout, pollInterval)
+}
+
+// WaitForStreamUpdate is like WaitForStream but waits for an update of the stream whose release had the given
+// version. A deployed stream still reports the state of that release until Skipper creates a newer one, so the stream
+// is deployed only once its release history shows a newer version and the stream and all its applications are
+// deployed.
+func WaitForStreamUpdate(ctx context.Context, client dataflow.Client, name string, previousVersion int, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	return newStreamWaiter(client, name, previousVersion, progressWriter).wait(ctx, timeout, pollInterval)
+}
+
+func newStreamWaiter(client dataflow.Client, name string, previousVersion int, progressWriter io.Writer) *streamWaiter {
+	return &streamWaiter{
+		client:          client,
+		name:            name,
+		previousVersion: previousVersion,
+		appStates:       map[string]string{},
+		progressWriter:  progressWriter,
+	}
+}
+
+func (w *streamWaiter) wait(ctx context.Context, timeout time.Duration, pollInterval time.Duration) (string, error) {
+	err := pluginutil.Poll(ctx, timeout, pollInterval, w.poll)
+	var timeoutError *pluginutil.TimeoutError
+	if errors.As(err, &timeoutError) {
+		if w.awaitingRelease() {
+			return "", &ExitError{
+				Code: ExitTimeout,
+				Err:  fmt.Errorf("%w waiting for stream %s to be updated. No release newer than version %d was created", err, w.name, w.previousVersion),
+			}
+		}
+		code := ExitTimeout
+		if w.streamState == dataflow.StatePartial || w.deployedApps() > 0 {
+			code = ExitPartial
+		}
+		return "", &ExitError{
+			Code: code,
+			Err:  fmt.Errorf("%w waiting for stream %s to be deployed. Stream is %s with %d of %d applications deployed", err, w.name, w.streamState, w.deployedApps(), len(w.appStates)),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Stream %s deployed\n", w.name), nil
+}
+
+type streamWaiter struct {
+	client          dataflow.Client
+	name            string
+	previousVersion int
+	version         int
+	streamState     string
+	appStates       map[string]string
+	progressWriter  io.Writer
+}
+
+func (w *streamWaiter) poll(ctx context.Context) (bool, error) {
+	if w.awaitingRelease() {
+		if err := w.pollRelease(ctx); err != nil || w.awaitingRelease() {
+			return false, err
+		}
+	}
+
+	stream, err := w.client.StreamDefinition(ctx, w.name)
+	if err != nil {
+		return false, err
+	}
+	statuses, err := w.client.StreamRuntimeStatus(ctx, w.name)
+	if err != nil {
+		return false, err
+	}
+
+	if stream.Status != w.streamState {
+		fmt.Fprintf(w.progressWriter, "Stream %s is %s\n", format.Bold(format.Cyan(w.name)), stream.Status)
+		w.streamState = stream.Status
+	}
+	for _, status := range statuses {
+		for _, app := range status.Apps() {
+			label := app.Label()
+			if app.State != w.appStates[label] {
+				fmt.Fprintf(w.progressWriter, "Application %s is %s (%s)\n", format.Bold(format.Cyan(label)), app.State, instanceSummary(app.AppInstances()))
+				w.appStates[label] = app.State
+			}
+			if isFailed(app.State) {
+				return false, &ExitError{Code: ExitFailed, Err: fmt.Errorf("Stream %s failed: application %s is %s", w.name, label, app.State)}
+			}
+		}
+	}
+
+	if isFailed(stream.Status) {
+		return false, &ExitError{Code: ExitFailed, Err: fmt.Errorf("Stream %s failed: stream is %s", w.name, stream.Status)}
+	}
+	if w.previousVersion > 0 {
+		return stream.Status == dataflow.StateDeployed && len(w.appStates) > 0 && w.deployedApps() == len(w.appStates), nil
+	}
+	return stream.Status == dataflow.StateDeployed, nil
+}
+
+// awaitingRelease returns whether the waiter is waiting for an update which has not yet created a new release.
+func (w *streamWaiter) awaitingRelease() bool {
+	return w.previousVersion > 0 && w.version == 0
+}
+
+// pollRelease records the version of the stream's current release if it is newer than the previous version.
+func (w *streamWaiter) pollRelease(ctx context.Context) error {
+	releases, err := w.client.StreamHistory(ctx, w.name)
+	if err != nil {
+		return err
+	}
+	if len(releases) == 0 || releases[0].Version <= w.previousVersion {
+		return nil
+	}
+	w.version = releases[0].Version
+	fmt.Fprintf(w.progressWriter, "Stream %s release version %d created\n", format.Bold(format.Cyan(w.name)), w.version)
+	return nil
+}
+
+func (w *streamWaiter) deployedApps() int {
+	deployed := 0
+	for _, state := range w.appStates {
+		if state == dataflow.StateDeployed {
+			deployed++
+		}
+	}
+	return deployed
+}
+
+func isFailed(state string) bool {
+	return state == dataflow.StateFailed || state == dataflow.StateError
+}
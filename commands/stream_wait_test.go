@@ -0,0 +1,285 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+)
+
+var _ = Describe("WaitForStream", func() {
+	const pollInterval = time.Millisecond
+
+	var (
+		ctx            context.Context
+		fakeClient     *dataflowfakes.FakeClient
+		streamStates   []string
+		appStates      [][2]string
+		progressWriter *bytes.Buffer
+		timeout        time.Duration
+		output         string
+		err            error
+	)
+
+	// runtimeStatus returns the runtime status of a stream whose applications have the given labels and states, each
+	// with a single instance in the state of its application.
+	runtimeStatus := func(labelsAndStates [][2]string) []dataflow.StreamStatus {
+		apps := []string{}
+		for _, app := range labelsAndStates {
+			apps = append(apps, fmt.Sprintf(`{"name": "%[1]s", "state": "%[2]s", "instances": {"_embedded": {"appInstanceStatusResourceList": [
+				{"instanceId": "ticktock-%[1]s-v1-0", "state": "%[2]s"}
+			]}}}`, app[0], app[1]))
+		}
+		var statuses []dataflow.StreamStatus
+		Expect(json.Unmarshal([]byte(`[{"name": "ticktock", "applications": {"_embedded": {"appStatusResourceList": [`+
+			strings.Join(apps, ",")+`]}}}]`), &statuses)).To(Succeed())
+		return statuses
+	}
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+		progressWriter = &bytes.Buffer{}
+		timeout = time.Second
+		streamStates = []string{"deploying", "deployed"}
+		appStates = [][2]string{{"time", "deployed"}, {"log", "deployed"}}
+
+		fakeClient.StreamDefinitionStub = func(ctx context.Context, name string) (*dataflow.StreamDefinition, error) {
+			state := streamStates[0]
+			if len(streamStates) > 1 {
+				streamStates = streamStates[1:]
+			}
+			return &dataflow.StreamDefinition{Name: name, Status: state}, nil
+		}
+		fakeClient.StreamRuntimeStatusStub = func(ctx context.Context, names ...string) ([]dataflow.StreamStatus, error) {
+			return runtimeStatus(appStates), nil
+		}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.WaitForStream(ctx, fakeClient, "ticktock", timeout, pollInterval, progressWriter)
+	})
+
+	exitCode := func() int {
+		var exitError *commands.ExitError
+		Expect(errors.As(err, &exitError)).To(BeTrue())
+		return exitError.Code
+	}
+
+	It("should wait for the stream to be deployed", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("Stream ticktock deployed\n"))
+		Expect(fakeClient.StreamDefinitionCallCount()).To(Equal(2))
+		_, name := fakeClient.StreamDefinitionArgsForCall(0)
+		Expect(name).To(Equal("ticktock"))
+		_, names := fakeClient.StreamRuntimeStatusArgsForCall(0)
+		Expect(names).To(Equal([]string{"ticktock"}))
+	})
+
+	It("should print state transitions only as they happen", func() {
+		Expect(progressWriter.String()).To(Equal("Stream ticktock is deploying\n" +
+			"Application time is deployed (1/1 deployed)\n" +
+			"Application log is deployed (1/1 deployed)\n" +
+			"Stream ticktock is deployed\n"))
+	})
+
+	Context("when an application fails", func() {
+		BeforeEach(func() {
+			streamStates = []string{"deploying"}
+			appStates = [][2]string{{"time", "deployed"}, {"log", "failed"}}
+		})
+
+		It("should fail with the failed exit code", func() {
+			Expect(err).To(MatchError("Stream ticktock failed: application log is failed"))
+			Expect(exitCode()).To(Equal(commands.ExitFailed))
+		})
+	})
+
+	Context("when the stream fails", func() {
+		BeforeEach(func() {
+			streamStates = []string{"error"}
+			appStates = nil
+		})
+
+		It("should fail with the failed exit code", func() {
+			Expect(err).To(MatchError("Stream ticktock failed: stream is error"))
+			Expect(exitCode()).To(Equal(commands.ExitFailed))
+		})
+	})
+
+	Context("when the stream is partially deployed when the timeout expires", func() {
+		BeforeEach(func() {
+			timeout = 20 * time.Millisecond
+			streamStates = []string{"partial"}
+			appStates = [][2]string{{"time", "deployed"}, {"log", "deploying"}}
+		})
+
+		It("should fail with the partial exit code", func() {
+			Expect(err).To(MatchError("Timed out after 20ms waiting for stream ticktock to be deployed. Stream is partial with 1 of 2 applications deployed"))
+			Expect(exitCode()).To(Equal(commands.ExitPartial))
+		})
+	})
+
+	Context("when no application is deployed when the timeout expires", func() {
+		BeforeEach(func() {
+			timeout = 20 * time.Millisecond
+			streamStates = []string{"deploying"}
+			appStates = [][2]string{{"time", "deploying"}, {"log", "deploying"}}
+		})
+
+		It("should fail with the timeout exit code", func() {
+			Expect(err).To(MatchError("Timed out after 20ms waiting for stream ticktock to be deployed. Stream is deploying with 0 of 2 applications deployed"))
+			Expect(exitCode()).To(Equal(commands.ExitTimeout))
+		})
+	})
+
+	Context("when the stream cannot be found", func() {
+		BeforeEach(func() {
+			fakeClient.StreamDefinitionStub = nil
+			fakeClient.StreamDefinitionReturns(nil, errors.New("not found"))
+		})
+
+		It("should return the error without an exit code", func() {
+			Expect(err).To(MatchError("not found"))
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("WaitForStreamUpdate", func() {
+	const pollInterval = time.Millisecond
+
+	var (
+		ctx            context.Context
+		fakeClient     *dataflowfakes.FakeClient
+		versions       []int
+		appStates      [][]dataflow.AppStatus
+		progressWriter *bytes.Buffer
+		timeout        time.Duration
+		output         string
+		err            error
+	)
+
+	// apps returns applications with the given labels and states.
+	apps := func(labelsAndStates ...string) []dataflow.AppStatus {
+		var statuses []dataflow.AppStatus
+		for i := 0; i < len(labelsAndStates); i += 2 {
+			statuses = append(statuses, dataflow.AppStatus{Name: labelsAndStates[i], State: labelsAndStates[i+1]})
+		}
+		return statuses
+	}
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+		progressWriter = &bytes.Buffer{}
+		timeout = time.Second
+		versions = []int{3, 3, 4}
+		appStates = [][]dataflow.AppStatus{
+			apps("time", "deployed", "log", "deploying"),
+			apps("time", "deployed", "log", "deployed"),
+		}
+
+		fakeClient.StreamHistoryStub = func(ctx context.Context, name string) ([]dataflow.StreamRelease, error) {
+			version := versions[0]
+			if len(versions) > 1 {
+				versions = versions[1:]
+			}
+			return []dataflow.StreamRelease{{Name: name, Version: version}}, nil
+		}
+		fakeClient.StreamDefinitionStub = func(ctx context.Context, name string) (*dataflow.StreamDefinition, error) {
+			return &dataflow.StreamDefinition{Name: name, Status: "deployed"}, nil
+		}
+		fakeClient.StreamRuntimeStatusStub = func(ctx context.Context, names ...string) ([]dataflow.StreamStatus, error) {
+			states := appStates[0]
+			if len(appStates) > 1 {
+				appStates = appStates[1:]
+			}
+			status := dataflow.StreamStatus{Name: "ticktock"}
+			status.Applications.Embedded.AppStatuses = states
+			return []dataflow.StreamStatus{status}, nil
+		}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.WaitForStreamUpdate(ctx, fakeClient, "ticktock", 3, timeout, pollInterval, progressWriter)
+	})
+
+	It("should wait for a newer release before checking the stream", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("Stream ticktock deployed\n"))
+		Expect(fakeClient.StreamHistoryCallCount()).To(Equal(3))
+		Expect(fakeClient.StreamDefinitionCallCount()).To(Equal(2))
+	})
+
+	It("should wait for all the applications to be deployed", func() {
+		Expect(progressWriter.String()).To(Equal("Stream ticktock release version 4 created\n" +
+			"Stream ticktock is deployed\n" +
+			"Application time is deployed (0/0 deployed)\n" +
+			"Application log is deploying (0/0 deployed)\n" +
+			"Application log is deployed (0/0 deployed)\n"))
+	})
+
+	Context("when no newer release is created before the timeout expires", func() {
+		BeforeEach(func() {
+			timeout = 20 * time.Millisecond
+			versions = []int{3}
+		})
+
+		It("should fail with the timeout exit code without checking the stream", func() {
+			Expect(err).To(MatchError("Timed out after 20ms waiting for stream ticktock to be updated. No release newer than version 3 was created"))
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			Expect(exitError.Code).To(Equal(commands.ExitTimeout))
+			Expect(fakeClient.StreamDefinitionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the release history cannot be fetched", func() {
+		BeforeEach(func() {
+			fakeClient.StreamHistoryStub = nil
+			fakeClient.StreamHistoryReturns(nil, errors.New("history unavailable"))
+		})
+
+		It("should return the error", func() {
+			Expect(err).To(MatchError("history unavailable"))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+// Streams lists the stream definitions of the given dataflow server with their status and description.
+func Streams(ctx context.Context, client dataflow.Client) (string, error) {
+	streams, err := client.StreamDefinitions(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(streams) == 0 {
+		return "No streams found\n", nil
+	}
+
+	rows := make([][]string, 0, len(streams))
+	for _, stream := range streams {
+		rows = append(rows, []string{stream.Name, stream.Status, stream.Description})
+	}
+	return format.Table([]string{"name", "status", "description"}, rows), nil
+}
+
+// Stream describes the stream with the given name: its definition, its deployment properties, and the runtime status
+// of its applications.
+func Stream(ctx context.Context, client dataflow.Client, name string) (string, error) {
+	stream, err := client.StreamDefinition(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	deployment, err := client.StreamDeployment(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	properties, err := deployment.Properties()
+	if err != nil {
+		return "", err
+	}
+	statuses, err := client.StreamRuntimeStatus(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	output.WriteString(format.Fields(
+		format.Field{Name: "name", Value: stream.Name},
+		format.Field{Name: "status", Value: stream.Status},
+		format.Field{Name: "description", Value: stream.Description},
+		format.Field{Name: "definition", Value: stream.DslText},
+	))
+
+	output.WriteString("\n")
+	if len(properties) == 0 {
+		output.WriteString("No deployment properties\n")
+	} else {
+		output.WriteString(propertiesTable(properties))
+	}
+
+	output.WriteString("\n")
+	apps := []dataflow.AppStatus{}
+	for _, status := range statuses {
+		apps = append(apps, status.Apps()...)
+	}
+	if len(apps) == 0 {
+		output.WriteString("No deployed applications\n")
+	} else {
+		rows := make([][]string, 0, len(apps))
+		for _, app := range apps {
+			rows = append(rows, []string{app.Label(), app.DeploymentId, app.State, instanceSummary(app.AppInstances())})
+		}
+		output.WriteString(format.Table([]string{"app", "deployment id", "state", "instances"}, rows))
+	}
+	return output.String(), nil
+}
+
+func propertiesTable(properties map[string]string) string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, []string{key, properties[key]})
+	}
+	return format.Table([]string{"property", "value"}, rows)
+}
+
+// instanceSummary summarises the states of an application's instances, for example "2/3 deployed".
+func instanceSummary(instances []dataflow.AppInstanceStatus) string {
+	return fmt.Sprintf("%d/%d %s", deployedInstances(instances), len(instances), dataflow.StateDeployed)
+}
+
+func deployedInstances(instances []dataflow.AppInstanceStatus) int {
+	deployed := 0
+	for _, instance := range instances {
+		if instance.State == dataflow.StateDeployed {
+			deployed++
+		}
+	}
+	return deployed
+}
+
+// CreateStream creates a stream with the given definition and description and, if deploy is true, deploys it using
+// the given deployment properties.
+func CreateStream(ctx context.Context, client dataflow.Client, name string, definition string, description string, deploy bool, properties map[string]string) (string, error) {
+	// The server deploys a stream as it is created only without deployment properties.
+	deployOnCreation := deploy && len(properties) == 0
+	if _, err := client.CreateStream(ctx, name, definition, description, deployOnCreation); err != nil {
+		return "", err
+	}
+	if !deploy {
+		return fmt.Sprintf("Stream %s created\n", name), nil
+	}
+	if !deployOnCreation {
+		if err := client.DeployStream(ctx, name, properties); err != nil {
+			return "", fmt.Errorf("Stream %s created but not deployed: %w", name, err)
+		}
+	}
+	return fmt.Sprintf("Stream %s created and deployment requested\n", name), nil
+}
+
+// DeployStream deploys the stream with the given name using the given deployment properties.
+func DeployStream(ctx context.Context, client dataflow.Client, name string, properties map[string]string) (string, error) {
+	if err := client.DeployStream(ctx, name, properties); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Deployment of stream %s requested\n", name), nil
+}
+
+// UndeployStream undeploys the stream with the given name.
+func UndeployStream(ctx context.Context, client dataflow.Client, name string) (string, error) {
+	if err := client.UndeployStream(ctx, name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Stream %s undeployed\n", name), nil
+}
+
+// DestroyStream destroys the stream with the given name, undeploying it if necessary.
+func DestroyStream(ctx context.Context, client dataflow.Client, name string) (string, error) {
+	if err := client.DestroyStream(ctx, name); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Stream %s destroyed\n", name), nil
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+)
+
+var _ = Describe("Stream commands", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("Streams", func() {
+		It("should list stream definitions", func() {
+			fakeClient.StreamDefinitionsReturns([]dataflow.StreamDefinition{
+				{Name: "ticktock", Status: "deployed", Description: "Tick tock"},
+				{Name: "http-log", Status: "undeployed"},
+			}, nil)
+
+			output, err := commands.Streams(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("name       status       description\n" +
+				"ticktock   deployed     Tick tock\n" +
+				"http-log   undeployed\n"))
+		})
+
+		It("should say when there are no streams", func() {
+			fakeClient.StreamDefinitionsReturns([]dataflow.StreamDefinition{}, nil)
+
+			output, err := commands.Streams(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("No streams found\n"))
+		})
+
+		It("should return any error", func() {
+			fakeClient.StreamDefinitionsReturns(nil, errors.New("failed"))
+
+			_, err := commands.Streams(ctx, fakeClient)
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+
+	Describe("Stream", func() {
+		BeforeEach(func() {
+			fakeClient.StreamDefinitionReturns(&dataflow.StreamDefinition{Name: "ticktock", Status: "deployed", Description: "Tick tock", DslText: "time | log"}, nil)
+			fakeClient.StreamDeploymentReturns(&dataflow.StreamDeployment{StreamName: "ticktock", DeploymentProperties: `{"log": {"count": "2"}, "time": {"spring.cloud.deployer.memory": "512m"}}`}, nil)
+
+			var statuses []dataflow.StreamStatus
+			Expect(json.Unmarshal([]byte(`[{"name": "ticktock", "applications": {"_embedded": {"appStatusResourceList": [
+				{"deploymentId": "ticktock-log-v1", "name": "log", "state": "partial", "instances": {"_embedded": {"appInstanceStatusResourceList": [
+					{"instanceId": "ticktock-log-v1-0", "state": "deployed", "attributes": {"skipper.application.name": "log"}},
+					{"instanceId": "ticktock-log-v1-1", "state": "deploying", "attributes": {"skipper.application.name": "log"}}
+				]}}},
+				{"deploymentId": "ticktock-time-v1", "name": "time", "state": "deployed", "instances": {"_embedded": {"appInstanceStatusResourceList": [
+					{"instanceId": "ticktock-time-v1-0", "state": "deployed"}
+				]}}}
+			]}}}]`), &statuses)).To(Succeed())
+			fakeClient.StreamRuntimeStatusReturns(statuses, nil)
+		})
+
+		It("should describe the stream", func() {
+			output, err := commands.Stream(ctx, fakeClient, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("name:          ticktock\n" +
+				"status:        deployed\n" +
+				"description:   Tick tock\n" +
+				"definition:    time | log\n" +
+				"\n" +
+				"property                            value\n" +
+				"log.count                           2\n" +
+				"time.spring.cloud.deployer.memory   512m\n" +
+				"\n" +
+				"app    deployment id      state      instances\n" +
+				"log    ticktock-log-v1    partial    1/2 deployed\n" +
+				"time   ticktock-time-v1   deployed   1/1 deployed\n"))
+
+			_, names := fakeClient.StreamRuntimeStatusArgsForCall(0)
+			Expect(names).To(Equal([]string{"ticktock"}))
+		})
+
+		It("should say when the stream is not deployed", func() {
+			fakeClient.StreamDeploymentReturns(&dataflow.StreamDeployment{StreamName: "ticktock"}, nil)
+			fakeClient.StreamRuntimeStatusReturns([]dataflow.StreamStatus{}, nil)
+
+			output, err := commands.Stream(ctx, fakeClient, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(HaveSuffix("\nNo deployment properties\n\nNo deployed applications\n"))
+		})
+
+		It("should return an error if the stream is not found", func() {
+			fakeClient.StreamDefinitionReturns(nil, errors.New("not found"))
+
+			_, err := commands.Stream(ctx, fakeClient, "ticktock")
+			Expect(err).To(MatchError("not found"))
+		})
+	})
+
+	Describe("CreateStream", func() {
+		It("should create the stream", func() {
+			output, err := commands.CreateStream(ctx, fakeClient, "ticktock", "time | log", "Tick tock", false, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock created\n"))
+
+			Expect(fakeClient.CreateStreamCallCount()).To(Equal(1))
+			_, name, definition, description, deploy := fakeClient.CreateStreamArgsForCall(0)
+			Expect([]string{name, definition, description}).To(Equal([]string{"ticktock", "time | log", "Tick tock"}))
+			Expect(deploy).To(BeFalse())
+			Expect(fakeClient.DeployStreamCallCount()).To(Equal(0))
+		})
+
+		It("should deploy the stream as it is created when there are no deployment properties", func() {
+			output, err := commands.CreateStream(ctx, fakeClient, "ticktock", "time | log", "", true, map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock created and deployment requested\n"))
+
+			_, _, _, _, deploy := fakeClient.CreateStreamArgsForCall(0)
+			Expect(deploy).To(BeTrue())
+			Expect(fakeClient.DeployStreamCallCount()).To(Equal(0))
+		})
+
+		It("should deploy the stream with deployment properties after creating it", func() {
+			output, err := commands.CreateStream(ctx, fakeClient, "ticktock", "time | log", "", true, map[string]string{"app.log.count": "2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock created and deployment requested\n"))
+
+			_, _, _, _, deploy := fakeClient.CreateStreamArgsForCall(0)
+			Expect(deploy).To(BeFalse())
+			Expect(fakeClient.DeployStreamCallCount()).To(Equal(1))
+			_, name, properties := fakeClient.DeployStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+			Expect(properties).To(Equal(map[string]string{"app.log.count": "2"}))
+		})
+
+		It("should report a stream which was created but not deployed", func() {
+			fakeClient.DeployStreamReturns(errors.New("failed"))
+
+			_, err := commands.CreateStream(ctx, fakeClient, "ticktock", "time | log", "", true, map[string]string{"app.log.count": "2"})
+			Expect(err).To(MatchError("Stream ticktock created but not deployed: failed"))
+		})
+
+		It("should return an error if the stream cannot be created", func() {
+			fakeClient.CreateStreamReturns(nil, errors.New("invalid definition"))
+
+			_, err := commands.CreateStream(ctx, fakeClient, "ticktock", "time |", "", true, map[string]string{"app.log.count": "2"})
+			Expect(err).To(MatchError("invalid definition"))
+			Expect(fakeClient.DeployStreamCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("DeployStream", func() {
+		It("should deploy the stream", func() {
+			output, err := commands.DeployStream(ctx, fakeClient, "ticktock", map[string]string{"app.log.count": "2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Deployment of stream ticktock requested\n"))
+			_, name, properties := fakeClient.DeployStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+			Expect(properties).To(Equal(map[string]string{"app.log.count": "2"}))
+		})
+
+		It("should return any error", func() {
+			fakeClient.DeployStreamReturns(errors.New("failed"))
+			_, err := commands.DeployStream(ctx, fakeClient, "ticktock", nil)
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+
+	Describe("UndeployStream", func() {
+		It("should undeploy the stream", func() {
+			output, err := commands.UndeployStream(ctx, fakeClient, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock undeployed\n"))
+			_, name := fakeClient.UndeployStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+		})
+
+		It("should return any error", func() {
+			fakeClient.UndeployStreamReturns(errors.New("failed"))
+			_, err := commands.UndeployStream(ctx, fakeClient, "ticktock")
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+
+	Describe("DestroyStream", func() {
+		It("should destroy the stream", func() {
+			output, err := commands.DestroyStream(ctx, fakeClient, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Stream ticktock destroyed\n"))
+			_, name := fakeClient.DestroyStreamArgsForCall(0)
+			Expect(name).To(Equal("ticktock"))
+		})
+
+		It("should return any error", func() {
+			fakeClient.DestroyStreamReturns(errors.New("failed"))
+			_, err := commands.DestroyStream(ctx, fakeClient, "ticktock")
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+// TaskCleanupCriteria selects the task executions to be removed by TaskCleanup from those which have ended,
+// successfully or not. Zero values select all task executions which have ended.
+type TaskCleanupCriteria struct {
+	// TaskName selects the executions of the task with the given name.
+	TaskName string
+
+	// Completed selects task executions which completed successfully, that is with exit code zero, leaving those
+	// which failed for inspection.
+	Completed bool
+
+	// OlderThan selects task executions which ended longer ago than the given duration.
+	OlderThan time.Duration
+
+	// KeepLast excludes the given number of most recent executions of each task which have ended.
+	KeepLast int
+}
+
+// TaskCleanup removes the task executions selected by the given criteria, together with their data, using at most the
+// given number of concurrent requests. Task executions which have not ended are never removed, since removing their
+// data would disrupt them. If dryRun is true, the task executions which would be removed are listed
+// instead. The given time is used as the current time for the age of task executions.
+func TaskCleanup(ctx context.Context, client dataflow.Client, criteria TaskCleanupCriteria, now time.Time, dryRun bool, parallelism int, progressWriter io.Writer) (string, error) {
+	executions, err := selectTaskExecutions(ctx, client, criteria, now)
+	if err != nil {
+		return "", err
+	}
+	if len(executions) == 0 {
+		return "No task executions match\n", nil
+	}
+
+	if dryRun {
+		rows := make([][]string, 0, len(executions))
+		for _, execution := range executions {
+			rows = append(rows, []string{
+				formatId(execution.ExecutionId),
+				execution.TaskName,
+				execution.TaskExecutionStatus,
+				format.Time(execution.StartTime.Time),
+				format.Time(execution.EndTime.Time),
+			})
+		}
+		return format.Table([]string{"id", "task", "status", "start time", "end time"}, rows) +
+			fmt.Sprintf("\n%s would be removed\n", pluralTaskExecutions(len(executions))), nil
+	}
+
+	fmt.Fprintf(progressWriter, "Removing %s\n", pluralTaskExecutions(len(executions)))
+	errs := make([]error, len(executions))
+	pluginutil.ForEachParallel(ctx, len(executions), parallelism, func(i int) {
+		errs[i] = client.CleanupTaskExecution(ctx, executions[i].ExecutionId, true)
+	})
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	failures := []string{}
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("task execution %d: %s", executions[i].ExecutionId, err))
+		}
+	}
+	if len(failures) > 0 {
+		return "", fmt.Errorf("Failed to remove %d of %s:\n%s", len(failures), pluralTaskExecutions(len(executions)), strings.Join(failures, "\n"))
+	}
+	return fmt.Sprintf("Removed %s\n", pluralTaskExecutions(len(executions))), nil
+}
+
+// selectTaskExecutions returns the task executions selected by the given criteria, most recent first. All the task
+// executions are fetched before any are removed, since removing them would change the pages of the collection.
+func selectTaskExecutions(ctx context.Context, client dataflow.Client, criteria TaskCleanupCriteria, now time.Time) ([]dataflow.TaskExecution, error) {
+	selected := []dataflow.TaskExecution{}
+	seen := map[string]int{}
+	iterator := client.TaskExecutions(ctx, criteria.TaskName, taskExecutionScanPageSize)
+	for {
+		var execution dataflow.TaskExecution
+		if !iterator.Next(&execution) {
+			return selected, iterator.Err()
+		}
+
+		if !taskExecutionEnded(&execution) {
+			continue
+		}
+		seen[execution.TaskName]++
+		if seen[execution.TaskName] <= criteria.KeepLast {
+			continue
+		}
+		if criteria.Completed && !completedSuccessfully(&execution) {
+			continue
+		}
+		if criteria.OlderThan > 0 && !olderThan(&execution, now.Add(-criteria.OlderThan)) {
+			continue
+		}
+		selected = append(selected, execution)
+	}
+}
+
+// completedSuccessfully reports whether the given task execution completed with exit code zero.
+func completedSuccessfully(execution *dataflow.TaskExecution) bool {
+	return execution.TaskExecutionStatus == dataflow.TaskExecutionComplete && execution.ExitCode != nil && *execution.ExitCode == 0
+}
+
+// olderThan reports whether the given task execution ended or, if its end time was not recorded, started before the
+// given time.
+func olderThan(execution *dataflow.TaskExecution, cutoff time.Time) bool {
+	t := execution.EndTime.Time
+	if t.IsZero() {
+		t = execution.StartTime.Time
+	}
+	return !t.IsZero() && t.Before(cutoff)
+}
+
+func pluralTaskExecutions(count int) string {
+	if count == 1 {
+		return "1 task execution"
+	}
+	return fmt.Sprintf("%d task executions", count)
+}
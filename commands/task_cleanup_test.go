@@ -0,0 +1,309 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal/halfakes"
+)
+
+var _ = Describe("TaskCleanup", func() {
+	var (
+		ctx            context.Context
+		fakeClient     *dataflowfakes.FakeClient
+		now            time.Time
+		criteria       commands.TaskCleanupCriteria
+		dryRun         bool
+		progressWriter *bytes.Buffer
+		removedIds     []int64
+		mutex          sync.Mutex
+		output         string
+		err            error
+	)
+
+	// execution returns the JSON of a task execution which ended the given number of days before now, or is still
+	// running if days is negative.
+	execution := func(id int64, taskName string, days int) string {
+		startTime := now.AddDate(0, 0, -days-1)
+		if days < 0 {
+			return fmt.Sprintf(`{"executionId": %d, "taskName": "%s", "taskExecutionStatus": "RUNNING", "startTime": %d}`, id, taskName, startTime.UnixNano()/1e6)
+		}
+		return fmt.Sprintf(`{"executionId": %d, "taskName": "%s", "taskExecutionStatus": "COMPLETE", "exitCode": 0, "startTime": %d, "endTime": %d}`,
+			id, taskName, startTime.UnixNano()/1e6, now.AddDate(0, 0, -days).UnixNano()/1e6)
+	}
+
+	removed := func() []int64 {
+		mutex.Lock()
+		defer mutex.Unlock()
+		ids := append([]int64{}, removedIds...)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+		return ids
+	}
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		now = time.Date(2019, 5, 31, 12, 0, 0, 0, time.UTC)
+		criteria = commands.TaskCleanupCriteria{}
+		dryRun = false
+		progressWriter = &bytes.Buffer{}
+		removedIds = nil
+
+		items := []string{
+			execution(6, "a", -1),
+			execution(5, "b", 1),
+			execution(4, "a", 2),
+			execution(3, "a", 10),
+			execution(2, "b", 20),
+			execution(1, "a", 30),
+		}
+		fakeIterator := &halfakes.FakeIterator{}
+		fakeIterator.NextStub = func(item interface{}) bool {
+			if len(items) == 0 {
+				return false
+			}
+			Expect(json.Unmarshal([]byte(items[0]), item)).To(Succeed())
+			items = items[1:]
+			return true
+		}
+
+		fakeClient = &dataflowfakes.FakeClient{}
+		fakeClient.TaskExecutionsReturns(fakeIterator)
+		fakeClient.CleanupTaskExecutionStub = func(ctx context.Context, id int64, removeData bool) error {
+			Expect(removeData).To(BeTrue())
+			mutex.Lock()
+			defer mutex.Unlock()
+			removedIds = append(removedIds, id)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.TaskCleanup(ctx, fakeClient, criteria, now, dryRun, 2, progressWriter)
+	})
+
+	It("should remove all the task executions which have ended", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("Removed 5 task executions\n"))
+		Expect(progressWriter.String()).To(Equal("Removing 5 task executions\n"))
+		Expect(removed()).To(Equal([]int64{5, 4, 3, 2, 1}))
+	})
+
+	It("should scan the task executions in large pages", func() {
+		_, _, pageSize := fakeClient.TaskExecutionsArgsForCall(0)
+		Expect(pageSize).To(BeNumerically(">=", 1000))
+	})
+
+	Context("when a task name is given", func() {
+		BeforeEach(func() {
+			criteria.TaskName = "a"
+		})
+
+		It("should iterate over the executions of that task", func() {
+			_, taskName, _ := fakeClient.TaskExecutionsArgsForCall(0)
+			Expect(taskName).To(Equal("a"))
+		})
+	})
+
+	Context("when a task execution has been running for longer than the age selected", func() {
+		BeforeEach(func() {
+			criteria.OlderThan = 7 * 24 * time.Hour
+			fakeIterator := &halfakes.FakeIterator{}
+			items := []string{
+				fmt.Sprintf(`{"executionId": 1, "taskName": "a", "taskExecutionStatus": "RUNNING", "startTime": %d}`, now.AddDate(0, 0, -30).UnixNano()/1e6),
+			}
+			fakeIterator.NextStub = func(item interface{}) bool {
+				if len(items) == 0 {
+					return false
+				}
+				Expect(json.Unmarshal([]byte(items[0]), item)).To(Succeed())
+				items = items[1:]
+				return true
+			}
+			fakeClient.TaskExecutionsReturns(fakeIterator)
+		})
+
+		It("should not remove it", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("No task executions match\n"))
+			Expect(fakeClient.CleanupTaskExecutionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when only successfully completed task executions are selected", func() {
+		BeforeEach(func() {
+			criteria.Completed = true
+			fakeIterator := &halfakes.FakeIterator{}
+			items := []string{
+				execution(5, "a", -1),
+				`{"executionId": 4, "taskName": "a", "taskExecutionStatus": "ERROR", "exitCode": 1}`,
+				`{"executionId": 3, "taskName": "a", "taskExecutionStatus": "COMPLETE", "exitCode": 2}`,
+				`{"executionId": 2, "taskName": "a", "taskExecutionStatus": "COMPLETE"}`,
+				execution(1, "a", 30),
+			}
+			fakeIterator.NextStub = func(item interface{}) bool {
+				if len(items) == 0 {
+					return false
+				}
+				Expect(json.Unmarshal([]byte(items[0]), item)).To(Succeed())
+				items = items[1:]
+				return true
+			}
+			fakeClient.TaskExecutionsReturns(fakeIterator)
+		})
+
+		It("should remove only those which completed with exit code zero", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed()).To(Equal([]int64{1}))
+		})
+	})
+
+	Context("when only old task executions are selected", func() {
+		BeforeEach(func() {
+			criteria.OlderThan = 7 * 24 * time.Hour
+		})
+
+		It("should remove task executions which ended before then", func() {
+			Expect(removed()).To(Equal([]int64{3, 2, 1}))
+		})
+	})
+
+	Context("when the most recent executions of each task are kept", func() {
+		BeforeEach(func() {
+			criteria.KeepLast = 2
+		})
+
+		It("should remove the older executions of each task", func() {
+			Expect(removed()).To(Equal([]int64{1}))
+		})
+
+		Context("and a task has a running execution", func() {
+			BeforeEach(func() {
+				criteria.KeepLast = 1
+			})
+
+			It("should keep the most recent executions which have ended", func() {
+				Expect(removed()).To(Equal([]int64{3, 2, 1}))
+			})
+		})
+	})
+
+	Context("when no task executions match", func() {
+		BeforeEach(func() {
+			criteria.OlderThan = 100 * 24 * time.Hour
+		})
+
+		It("should say so", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("No task executions match\n"))
+			Expect(fakeClient.CleanupTaskExecutionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when doing a dry run", func() {
+		BeforeEach(func() {
+			dryRun = true
+			criteria.OlderThan = 15 * 24 * time.Hour
+		})
+
+		It("should list the task executions which would be removed without removing them", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(MatchRegexp(`^id   task   status     start time +end time\n` +
+				`2    b      COMPLETE   \S.* {3}\S.*\n` +
+				`1    a      COMPLETE   \S.* {3}\S.*\n` +
+				`\n2 task executions would be removed\n$`))
+			Expect(fakeClient.CleanupTaskExecutionCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when removing some task executions fails", func() {
+		BeforeEach(func() {
+			fakeClient.CleanupTaskExecutionStub = func(ctx context.Context, id int64, removeData bool) error {
+				if id%2 == 0 {
+					return errors.New("still running")
+				}
+				return nil
+			}
+		})
+
+		It("should report the failures", func() {
+			Expect(err).To(MatchError("Failed to remove 2 of 5 task executions:\n" +
+				"task execution 4: still running\n" +
+				"task execution 2: still running"))
+		})
+	})
+
+	Context("when there are many task executions to remove", func() {
+		var maxConcurrent int
+
+		BeforeEach(func() {
+			active := 0
+			maxConcurrent = 0
+			fakeClient.CleanupTaskExecutionStub = func(ctx context.Context, id int64, removeData bool) error {
+				mutex.Lock()
+				active++
+				if active > maxConcurrent {
+					maxConcurrent = active
+				}
+				mutex.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mutex.Lock()
+				active--
+				mutex.Unlock()
+				return nil
+			}
+		})
+
+		It("should remove them concurrently within the parallelism limit", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.CleanupTaskExecutionCallCount()).To(Equal(5))
+			Expect(maxConcurrent).To(Equal(2))
+		})
+	})
+
+	Context("when iterating over the task executions fails", func() {
+		BeforeEach(func() {
+			fakeIterator := &halfakes.FakeIterator{}
+			fakeIterator.ErrReturns(errors.New("failed"))
+			fakeClient.TaskExecutionsReturns(fakeIterator)
+		})
+
+		It("should return the error without removing any task executions", func() {
+			Expect(err).To(MatchError("failed"))
+			Expect(fakeClient.CleanupTaskExecutionCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+// taskExecutionScanPageSize is the page size requested when scanning the whole history of task executions, so that
+// long histories take few requests.
+const taskExecutionScanPageSize = 1000
+
+// TaskExecutions lists the given page, numbered from one, of the task executions of the task with the given name or,
+// if the name is empty, of all tasks, most recent first. If statuses are given, only task executions with one of those
+// statuses are listed and pages consist of the matching task executions.
+func TaskExecutions(ctx context.Context, client dataflow.Client, taskName string, statuses []string, page int, size int) (string, error) {
+	var (
+		executions []dataflow.TaskExecution
+		more       bool
+		err        error
+	)
+	if len(statuses) == 0 {
+		executions, more, err = taskExecutionPage(ctx, client, taskName, page, size)
+	} else {
+		executions, more, err = filteredTaskExecutionPage(ctx, client, taskName, statuses, page, size)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(executions) == 0 {
+		if page > 1 {
+			return fmt.Sprintf("No task executions found on page %d\n", page), nil
+		}
+		return "No task executions found\n", nil
+	}
+
+	rows := make([][]string, 0, len(executions))
+	for _, execution := range executions {
+		rows = append(rows, []string{
+			formatId(execution.ExecutionId),
+			execution.TaskName,
+			execution.TaskExecutionStatus,
+			format.Time(execution.StartTime.Time),
+			format.Time(execution.EndTime.Time),
+			formatExitCode(execution.ExitCode),
+		})
+	}
+	output := format.Table([]string{"id", "task", "status", "start time", "end time", "exit code"}, rows)
+	if more {
+		output += fmt.Sprintf("\nShowing page %d. Specify --page %d to show more task executions.\n", page, page+1)
+	}
+	return output, nil
+}
+
+// taskExecutionPage fetches the given page of task executions from the server and reports whether there are more.
+func taskExecutionPage(ctx context.Context, client dataflow.Client, taskName string, page int, size int) ([]dataflow.TaskExecution, bool, error) {
+	executionPage, err := client.TaskExecutionPage(ctx, taskName, page-1, size)
+	if err != nil {
+		return nil, false, err
+	}
+	more := executionPage.Page != nil && executionPage.Page.Number+1 < executionPage.Page.TotalPages
+	return executionPage.Executions, more, nil
+}
+
+// filteredTaskExecutionPage iterates over the task executions, since the server cannot filter them by status, and
+// returns the given page of those with one of the given statuses, reporting whether there are more.
+func filteredTaskExecutionPage(ctx context.Context, client dataflow.Client, taskName string, statuses []string, page int, size int) ([]dataflow.TaskExecution, bool, error) {
+	skip := (page - 1) * size
+	executions := []dataflow.TaskExecution{}
+	iterator := client.TaskExecutions(ctx, taskName, taskExecutionScanPageSize)
+	for {
+		var execution dataflow.TaskExecution
+		if !iterator.Next(&execution) {
+			return executions, false, iterator.Err()
+		}
+		if !containsString(statuses, execution.TaskExecutionStatus) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		if len(executions) == size {
+			return executions, true, nil
+		}
+		executions = append(executions, execution)
+	}
+}
+
+// TaskExecution describes the task execution with the given ID and, if logs is true, shows its log.
+func TaskExecution(ctx context.Context, client dataflow.Client, id int64, logs bool) (string, error) {
+	execution, err := client.TaskExecution(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	output.WriteString(format.Fields(
+		format.Field{Name: "id", Value: formatId(execution.ExecutionId)},
+		format.Field{Name: "task", Value: execution.TaskName},
+		format.Field{Name: "status", Value: execution.TaskExecutionStatus},
+		format.Field{Name: "arguments", Value: strings.Join(execution.Arguments, "\n")},
+		format.Field{Name: "start time", Value: format.Time(execution.StartTime.Time)},
+		format.Field{Name: "end time", Value: format.Time(execution.EndTime.Time)},
+		format.Field{Name: "exit code", Value: formatExitCode(execution.ExitCode)},
+		format.Field{Name: "exit message", Value: execution.ExitMessage},
+		format.Field{Name: "error message", Value: execution.ErrorMessage},
+		format.Field{Name: "external execution id", Value: execution.ExternalExecutionId},
+		format.Field{Name: "platform", Value: execution.PlatformName},
+	))
+	if !logs {
+		return output.String(), nil
+	}
+
+	output.WriteString("\n")
+	if execution.ExternalExecutionId == "" {
+		output.WriteString("No log is available because the task execution has no external execution ID\n")
+		return output.String(), nil
+	}
+	log, err := client.TaskLog(ctx, execution.ExternalExecutionId, execution.PlatformName)
+	if err != nil {
+		return "", fmt.Errorf("Failed to fetch the log of task execution %d: %w", id, err)
+	}
+	if log == "" {
+		output.WriteString("The task execution log is empty\n")
+		return output.String(), nil
+	}
+	output.WriteString(format.Bold("log:") + "\n")
+	output.WriteString(log)
+	if !strings.HasSuffix(log, "\n") {
+		output.WriteString("\n")
+	}
+	return output.String(), nil
+}
+
+func formatId(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func formatExitCode(exitCode *int) string {
+	if exitCode == nil {
+		return ""
+	}
+	return strconv.Itoa(*exitCode)
+}
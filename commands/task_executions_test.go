@@ -0,0 +1,251 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal/halfakes"
+)
+
+var _ = Describe("Task execution commands", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+	)
+
+	exitCode := func(code int) *int {
+		return &code
+	}
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("TaskExecutions", func() {
+		var (
+			startTime time.Time
+			endTime   time.Time
+		)
+
+		BeforeEach(func() {
+			startTime = time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+			endTime = startTime.Add(time.Minute)
+		})
+
+		Context("when no statuses are given", func() {
+			BeforeEach(func() {
+				fakeClient.TaskExecutionPageReturns(&dataflow.TaskExecutionPage{
+					Executions: []dataflow.TaskExecution{
+						{ExecutionId: 2, TaskName: "timestamp", TaskExecutionStatus: "RUNNING", StartTime: hal.Time{Time: startTime}},
+						{ExecutionId: 1, TaskName: "timestamp", TaskExecutionStatus: "COMPLETE", StartTime: hal.Time{Time: startTime}, EndTime: hal.Time{Time: endTime}, ExitCode: exitCode(0)},
+					},
+					Page: &hal.Page{Size: 2, TotalElements: 5, TotalPages: 3, Number: 0},
+				}, nil)
+			})
+
+			It("should list the requested page from the server", func() {
+				output, err := commands.TaskExecutions(ctx, fakeClient, "timestamp", nil, 1, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(Equal("id   task        status     start time                end time                  exit code\n" +
+					"2    timestamp   RUNNING    " + format.Time(startTime) + "\n" +
+					"1    timestamp   COMPLETE   " + format.Time(startTime) + "   " + format.Time(endTime) + "   0\n" +
+					"\nShowing page 1. Specify --page 2 to show more task executions.\n"))
+
+				_, taskName, page, size := fakeClient.TaskExecutionPageArgsForCall(0)
+				Expect(taskName).To(Equal("timestamp"))
+				Expect(page).To(Equal(0))
+				Expect(size).To(Equal(2))
+			})
+
+			It("should not offer another page after the last page", func() {
+				fakeClient.TaskExecutionPageReturns(&dataflow.TaskExecutionPage{
+					Executions: []dataflow.TaskExecution{{ExecutionId: 1, TaskName: "timestamp"}},
+					Page:       &hal.Page{Size: 2, TotalElements: 5, TotalPages: 3, Number: 2},
+				}, nil)
+
+				output, err := commands.TaskExecutions(ctx, fakeClient, "", nil, 3, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).NotTo(ContainSubstring("--page"))
+			})
+
+			It("should say when there are no task executions", func() {
+				fakeClient.TaskExecutionPageReturns(&dataflow.TaskExecutionPage{Executions: []dataflow.TaskExecution{}}, nil)
+
+				output, err := commands.TaskExecutions(ctx, fakeClient, "", nil, 1, 20)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(Equal("No task executions found\n"))
+			})
+
+			It("should return any error", func() {
+				fakeClient.TaskExecutionPageReturns(nil, errors.New("failed"))
+
+				_, err := commands.TaskExecutions(ctx, fakeClient, "", nil, 1, 20)
+				Expect(err).To(MatchError("failed"))
+			})
+		})
+
+		Context("when statuses are given", func() {
+			var fakeIterator *halfakes.FakeIterator
+
+			BeforeEach(func() {
+				items := []string{
+					`{"executionId": 6, "taskName": "a", "taskExecutionStatus": "ERROR", "exitCode": 1}`,
+					`{"executionId": 5, "taskName": "a", "taskExecutionStatus": "COMPLETE", "exitCode": 0}`,
+					`{"executionId": 4, "taskName": "b", "taskExecutionStatus": "ERROR", "exitCode": 2}`,
+					`{"executionId": 3, "taskName": "b", "taskExecutionStatus": "UNKNOWN"}`,
+					`{"executionId": 2, "taskName": "a", "taskExecutionStatus": "ERROR", "exitCode": 3}`,
+				}
+				fakeIterator = &halfakes.FakeIterator{}
+				fakeIterator.NextStub = func(item interface{}) bool {
+					if len(items) == 0 {
+						return false
+					}
+					Expect(json.Unmarshal([]byte(items[0]), item)).To(Succeed())
+					items = items[1:]
+					return true
+				}
+				fakeClient.TaskExecutionsReturns(fakeIterator)
+			})
+
+			It("should list the matching task executions", func() {
+				output, err := commands.TaskExecutions(ctx, fakeClient, "", []string{"ERROR", "UNKNOWN"}, 1, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(Equal("id   task   status   start time   end time   exit code\n" +
+					"6    a      ERROR                            1\n" +
+					"4    b      ERROR                            2\n" +
+					"\nShowing page 1. Specify --page 2 to show more task executions.\n"))
+				Expect(fakeClient.TaskExecutionPageCallCount()).To(Equal(0))
+			})
+
+			It("should list later pages of the matching task executions", func() {
+				output, err := commands.TaskExecutions(ctx, fakeClient, "", []string{"ERROR"}, 2, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(Equal("id   task   status   start time   end time   exit code\n" +
+					"2    a      ERROR                            3\n"))
+			})
+
+			It("should say when a page has no matching task executions", func() {
+				output, err := commands.TaskExecutions(ctx, fakeClient, "", []string{"RUNNING"}, 2, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(Equal("No task executions found on page 2\n"))
+			})
+
+			It("should return an error from iterating", func() {
+				fakeIterator.NextStub = nil
+				fakeIterator.NextReturns(false)
+				fakeIterator.ErrReturns(errors.New("failed"))
+
+				_, err := commands.TaskExecutions(ctx, fakeClient, "", []string{"ERROR"}, 1, 2)
+				Expect(err).To(MatchError("failed"))
+			})
+		})
+	})
+
+	Describe("TaskExecution", func() {
+		var startTime time.Time
+
+		BeforeEach(func() {
+			startTime = time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)
+			fakeClient.TaskExecutionReturns(&dataflow.TaskExecution{
+				ExecutionId:         7,
+				TaskName:            "timestamp",
+				TaskExecutionStatus: "ERROR",
+				Arguments:           []string{"--format=yyyy", "--spring.cloud.task.executionid=7"},
+				StartTime:           hal.Time{Time: startTime},
+				EndTime:             hal.Time{Time: startTime.Add(time.Minute)},
+				ExitCode:            exitCode(1),
+				ExitMessage:         "Bad format",
+				ErrorMessage:        "java.lang.IllegalArgumentException",
+				ExternalExecutionId: "timestamp-abc",
+				PlatformName:        "default",
+			}, nil)
+		})
+
+		It("should describe the task execution", func() {
+			output, err := commands.TaskExecution(ctx, fakeClient, 7, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("id:                      7\n" +
+				"task:                    timestamp\n" +
+				"status:                  ERROR\n" +
+				"arguments:               --format=yyyy\n" +
+				"                         --spring.cloud.task.executionid=7\n" +
+				"start time:              " + format.Time(startTime) + "\n" +
+				"end time:                " + format.Time(startTime.Add(time.Minute)) + "\n" +
+				"exit code:               1\n" +
+				"exit message:            Bad format\n" +
+				"error message:           java.lang.IllegalArgumentException\n" +
+				"external execution id:   timestamp-abc\n" +
+				"platform:                default\n"))
+			_, id := fakeClient.TaskExecutionArgsForCall(0)
+			Expect(id).To(Equal(int64(7)))
+			Expect(fakeClient.TaskLogCallCount()).To(Equal(0))
+		})
+
+		It("should show the log if requested", func() {
+			fakeClient.TaskLogReturns("line 1\nline 2", nil)
+
+			output, err := commands.TaskExecution(ctx, fakeClient, 7, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(HaveSuffix("platform:                default\n\nlog:\nline 1\nline 2\n"))
+
+			_, externalExecutionId, platform := fakeClient.TaskLogArgsForCall(0)
+			Expect(externalExecutionId).To(Equal("timestamp-abc"))
+			Expect(platform).To(Equal("default"))
+		})
+
+		It("should explain when no log is available", func() {
+			fakeClient.TaskExecutionReturns(&dataflow.TaskExecution{ExecutionId: 7, TaskName: "timestamp"}, nil)
+
+			output, err := commands.TaskExecution(ctx, fakeClient, 7, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(HaveSuffix("\n\nNo log is available because the task execution has no external execution ID\n"))
+			Expect(fakeClient.TaskLogCallCount()).To(Equal(0))
+		})
+
+		It("should return an error fetching the log", func() {
+			fakeClient.TaskLogReturns("", errors.New("not found"))
+
+			_, err := commands.TaskExecution(ctx, fakeClient, 7, true)
+			Expect(err).To(MatchError("Failed to fetch the log of task execution 7: not found"))
+		})
+
+		It("should return an error if the task execution is not found", func() {
+			fakeClient.TaskExecutionReturns(nil, errors.New("not found"))
+
+			_, err := commands.TaskExecution(ctx, fakeClient, 7, false)
+			Expect(err).To(MatchError("not found"))
+		})
+	})
+})
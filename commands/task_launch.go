@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+// LaunchTask launches the task with the given name and returns the ID of the new task execution.
+func LaunchTask(ctx context.Context, client dataflow.Client, name string, launch dataflow.TaskLaunch) (int64, string, error) {
+	executionId, err := client.LaunchTask(ctx, name, launch)
+	if err != nil {
+		return 0, "", err
+	}
+	return executionId, fmt.Sprintf("Task %s launched as task execution %d\n", name, executionId), nil
+}
+
+// WaitForTaskExecution waits, polling at the given interval, until the task execution with the given ID ends, writing
+// changes to its status to the given writer as they are seen. If the task exits with a non-zero exit code, the error
+// is an *ExitError with the task's exit code or, if the server did not record one or the exit code is not a valid
+// process exit code between 1 and 255, ExitFailed. If the task execution does not end within the given timeout, the
+// error is an *ExitError with code ExitTimeout. A task's own exit codes of 2 and 4 cannot be distinguished from these.
+func WaitForTaskExecution(ctx context.Context, client dataflow.Client, id int64, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	var execution *dataflow.TaskExecution
+	lastStatus := ""
+	err := pluginutil.Poll(ctx, timeout, pollInterval, func(ctx context.Context) (bool, error) {
+		var err error
+		if execution, err = client.TaskExecution(ctx, id); err != nil {
+			return false, err
+		}
+		if execution.TaskExecutionStatus != lastStatus {
+			fmt.Fprintf(progressWriter, "Task execution %s is %s\n", format.Bold(format.Cyan(fmt.Sprint(id))), execution.TaskExecutionStatus)
+			lastStatus = execution.TaskExecutionStatus
+		}
+		return taskExecutionEnded(execution), nil
+	})
+
+	var timeoutError *pluginutil.TimeoutError
+	if errors.As(err, &timeoutError) {
+		return "", &ExitError{
+			Code: ExitTimeout,
+			Err:  fmt.Errorf("%w waiting for task execution %d to end. Task execution is %s", err, id, lastStatus),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if execution.ExitCode == nil {
+		return "", &ExitError{Code: ExitFailed, Err: fmt.Errorf("Task execution %d of task %s ended without an exit code%s", id, execution.TaskName, taskExecutionMessages(execution))}
+	}
+	if code := *execution.ExitCode; code != 0 {
+		err := fmt.Errorf("Task execution %d of task %s failed with exit code %d%s", id, execution.TaskName, code, taskExecutionMessages(execution))
+		if code < 1 || code > 255 {
+			code = ExitFailed
+		}
+		return "", &ExitError{Code: code, Err: err}
+	}
+	return fmt.Sprintf("Task execution %d of task %s completed with exit code 0\n", id, execution.TaskName), nil
+}
+
+func taskExecutionEnded(execution *dataflow.TaskExecution) bool {
+	return execution.TaskExecutionStatus == dataflow.TaskExecutionComplete || execution.TaskExecutionStatus == dataflow.TaskExecutionError
+}
+
+// taskExecutionMessages returns the exit and error messages of the given task execution, if any, as a suffix for an
+// error message.
+func taskExecutionMessages(execution *dataflow.TaskExecution) string {
+	messages := []string{}
+	for _, message := range []string{execution.ExitMessage, execution.ErrorMessage} {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	if len(messages) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(messages, "\n")
+}
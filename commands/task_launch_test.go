@@ -0,0 +1,199 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+)
+
+var _ = Describe("Task launching", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("LaunchTask", func() {
+		It("should launch the task and return the execution ID", func() {
+			fakeClient.LaunchTaskReturns(42, nil)
+			launch := dataflow.TaskLaunch{Arguments: []string{"--a=b"}, Properties: map[string]string{"app.timestamp.format": "yyyy"}, Platform: "other"}
+
+			executionId, output, err := commands.LaunchTask(ctx, fakeClient, "timestamp", launch)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executionId).To(Equal(int64(42)))
+			Expect(output).To(Equal("Task timestamp launched as task execution 42\n"))
+
+			_, name, launchArg := fakeClient.LaunchTaskArgsForCall(0)
+			Expect(name).To(Equal("timestamp"))
+			Expect(launchArg).To(Equal(launch))
+		})
+
+		It("should return any error", func() {
+			fakeClient.LaunchTaskReturns(0, errors.New("not found"))
+
+			_, _, err := commands.LaunchTask(ctx, fakeClient, "timestamp", dataflow.TaskLaunch{})
+			Expect(err).To(MatchError("not found"))
+		})
+	})
+
+	Describe("WaitForTaskExecution", func() {
+		var (
+			executions     []dataflow.TaskExecution
+			progressWriter *bytes.Buffer
+			timeout        time.Duration
+			output         string
+			err            error
+		)
+
+		exitCode := func(code int) *int {
+			return &code
+		}
+
+		BeforeEach(func() {
+			progressWriter = &bytes.Buffer{}
+			timeout = time.Second
+			executions = []dataflow.TaskExecution{
+				{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionUnknown},
+				{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionRunning},
+				{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionComplete, ExitCode: exitCode(0)},
+			}
+			fakeClient.TaskExecutionStub = func(ctx context.Context, id int64) (*dataflow.TaskExecution, error) {
+				execution := executions[0]
+				if len(executions) > 1 {
+					executions = executions[1:]
+				}
+				return &execution, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			output, err = commands.WaitForTaskExecution(ctx, fakeClient, 42, timeout, time.Millisecond, progressWriter)
+		})
+
+		exitErrorCode := func() int {
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			return exitError.Code
+		}
+
+		It("should wait for the task execution to complete", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Task execution 42 of task timestamp completed with exit code 0\n"))
+			Expect(progressWriter.String()).To(Equal("Task execution 42 is UNKNOWN\n" +
+				"Task execution 42 is RUNNING\n" +
+				"Task execution 42 is COMPLETE\n"))
+			_, id := fakeClient.TaskExecutionArgsForCall(0)
+			Expect(id).To(Equal(int64(42)))
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				executions = []dataflow.TaskExecution{
+					{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionError, ExitCode: exitCode(7), ExitMessage: "Bad input", ErrorMessage: "java.lang.IllegalStateException"},
+				}
+			})
+
+			It("should fail with the task's exit code and messages", func() {
+				Expect(err).To(MatchError("Task execution 42 of task timestamp failed with exit code 7: Bad input\njava.lang.IllegalStateException"))
+				Expect(exitErrorCode()).To(Equal(7))
+			})
+		})
+
+		Context("when the task's exit code is not a valid process exit code", func() {
+			BeforeEach(func() {
+				executions = []dataflow.TaskExecution{
+					{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionError, ExitCode: exitCode(256)},
+				}
+			})
+
+			It("should fail with the failed exit code", func() {
+				Expect(err).To(MatchError("Task execution 42 of task timestamp failed with exit code 256"))
+				Expect(exitErrorCode()).To(Equal(commands.ExitFailed))
+			})
+		})
+
+		Context("when the task's exit code is negative", func() {
+			BeforeEach(func() {
+				executions = []dataflow.TaskExecution{
+					{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionError, ExitCode: exitCode(-1)},
+				}
+			})
+
+			It("should fail with the failed exit code", func() {
+				Expect(err).To(MatchError("Task execution 42 of task timestamp failed with exit code -1"))
+				Expect(exitErrorCode()).To(Equal(commands.ExitFailed))
+			})
+		})
+
+		Context("when the task ends without an exit code", func() {
+			BeforeEach(func() {
+				executions = []dataflow.TaskExecution{
+					{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionError},
+				}
+			})
+
+			It("should fail with the failed exit code", func() {
+				Expect(err).To(MatchError("Task execution 42 of task timestamp ended without an exit code"))
+				Expect(exitErrorCode()).To(Equal(commands.ExitFailed))
+			})
+		})
+
+		Context("when the task does not end in time", func() {
+			BeforeEach(func() {
+				timeout = 20 * time.Millisecond
+				executions = []dataflow.TaskExecution{
+					{TaskName: "timestamp", TaskExecutionStatus: dataflow.TaskExecutionRunning},
+				}
+			})
+
+			It("should fail with the timeout exit code", func() {
+				Expect(err).To(MatchError("Timed out after 20ms waiting for task execution 42 to end. Task execution is RUNNING"))
+				Expect(exitErrorCode()).To(Equal(commands.ExitTimeout))
+			})
+		})
+
+		Context("when the task execution cannot be found", func() {
+			BeforeEach(func() {
+				fakeClient.TaskExecutionStub = nil
+				fakeClient.TaskExecutionReturns(nil, errors.New("not found"))
+			})
+
+			It("should return the error", func() {
+				Expect(err).To(MatchError("not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+// Tasks lists the task definitions of the given dataflow server with their status and description.
+func Tasks(ctx context.Context, client dataflow.Client) (string, error) {
+	tasks, err := client.TaskDefinitions(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(tasks) == 0 {
+		return "No tasks found\n", nil
+	}
+
+	rows := make([][]string, 0, len(tasks))
+	for _, task := range tasks {
+		rows = append(rows, []string{task.Name, task.Status, task.Description})
+	}
+	return format.Table([]string{"name", "status", "description"}, rows), nil
+}
+
+// CreateTask creates a task with the given definition and description.
+func CreateTask(ctx context.Context, client dataflow.Client, name string, definition string, description string) (string, error) {
+	if _, err := client.CreateTask(ctx, name, definition, description); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Task %s created\n", name), nil
+}
+
+// DestroyTask destroys the task with the given name and, if cleanup is true, cleans up the resources of its task
+// executions.
+func DestroyTask(ctx context.Context, client dataflow.Client, name string, cleanup bool) (string, error) {
+	if err := client.DestroyTask(ctx, name, cleanup); err != nil {
+		return "", err
+	}
+	if cleanup {
+		return fmt.Sprintf("Task %s destroyed and its task executions cleaned up\n", name), nil
+	}
+	return fmt.Sprintf("Task %s destroyed\n", name), nil
+}
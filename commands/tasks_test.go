@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow/dataflowfakes"
+)
+
+var _ = Describe("Task commands", func() {
+	var (
+		ctx        context.Context
+		fakeClient *dataflowfakes.FakeClient
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+		ctx = context.Background()
+		fakeClient = &dataflowfakes.FakeClient{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	Describe("Tasks", func() {
+		It("should list task definitions", func() {
+			fakeClient.TaskDefinitionsReturns([]dataflow.TaskDefinition{
+				{Name: "timestamp", Status: "COMPLETE", Description: "Print the time"},
+				{Name: "import", Status: "UNKNOWN"},
+			}, nil)
+
+			output, err := commands.Tasks(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("name        status     description\n" +
+				"timestamp   COMPLETE   Print the time\n" +
+				"import      UNKNOWN\n"))
+		})
+
+		It("should say when there are no tasks", func() {
+			fakeClient.TaskDefinitionsReturns([]dataflow.TaskDefinition{}, nil)
+
+			output, err := commands.Tasks(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("No tasks found\n"))
+		})
+
+		It("should return any error", func() {
+			fakeClient.TaskDefinitionsReturns(nil, errors.New("failed"))
+
+			_, err := commands.Tasks(ctx, fakeClient)
+			Expect(err).To(MatchError("failed"))
+		})
+	})
+
+	Describe("CreateTask", func() {
+		It("should create the task", func() {
+			output, err := commands.CreateTask(ctx, fakeClient, "timestamp", "timestamp --format=yyyy", "Print the time")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Task timestamp created\n"))
+
+			Expect(fakeClient.CreateTaskCallCount()).To(Equal(1))
+			_, name, definition, description := fakeClient.CreateTaskArgsForCall(0)
+			Expect(name).To(Equal("timestamp"))
+			Expect(definition).To(Equal("timestamp --format=yyyy"))
+			Expect(description).To(Equal("Print the time"))
+		})
+
+		It("should return any error", func() {
+			fakeClient.CreateTaskReturns(nil, errors.New("exists"))
+
+			_, err := commands.CreateTask(ctx, fakeClient, "timestamp", "timestamp", "")
+			Expect(err).To(MatchError("exists"))
+		})
+	})
+
+	Describe("DestroyTask", func() {
+		It("should destroy the task", func() {
+			output, err := commands.DestroyTask(ctx, fakeClient, "timestamp", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Task timestamp destroyed\n"))
+
+			_, name, cleanup := fakeClient.DestroyTaskArgsForCall(0)
+			Expect(name).To(Equal("timestamp"))
+			Expect(cleanup).To(BeFalse())
+		})
+
+		It("should clean up task executions if requested", func() {
+			output, err := commands.DestroyTask(ctx, fakeClient, "timestamp", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Task timestamp destroyed and its task executions cleaned up\n"))
+
+			_, _, cleanup := fakeClient.DestroyTaskArgsForCall(0)
+			Expect(cleanup).To(BeTrue())
+		})
+
+		It("should return any error", func() {
+			fakeClient.DestroyTaskReturns(errors.New("not found"))
+
+			_, err := commands.DestroyTask(ctx, fakeClient, "timestamp", false)
+			Expect(err).To(MatchError("not found"))
+		})
+	})
+})
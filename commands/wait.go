@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+)
+
+// Last operation states of a service instance.
+const (
+	operationInProgress = "in progress"
+	operationFailed     = "failed"
+)
+
+const healthUp = "UP"
+
+// DataflowWait waits, polling at the given interval, until the service instance with the given name in the given org
+// and space, which default to the targeted org and space, is usable: its last operation has succeeded, its dataflow
+// server URL can be resolved, and the dataflow server is ready as in DataflowServerWait. Progress is written to the
+// given writer whenever the status changes. If the last operation fails, the error is an *ExitError with code
+// ExitFailed. If the service instance is not usable within the given timeout, the error is an *ExitError with code
+// ExitTimeout.
+func DataflowWait(ctx context.Context, cliConnection plugin.CliConnection, serverCache serviceutil.ServerCache, authClient httpclient.AuthenticatedClient, accessToken string, org string, space string, serviceInstanceName string, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	w := &serviceWaiter{
+		cliConnection:       cliConnection,
+		authClient:          authClient,
+		accessToken:         accessToken,
+		org:                 org,
+		space:               space,
+		serviceInstanceName: serviceInstanceName,
+		progressWriter:      progressWriter,
+	}
+
+	err := pluginutil.Poll(ctx, timeout, pollInterval, w.poll)
+	var timeoutError *pluginutil.TimeoutError
+	if errors.As(err, &timeoutError) {
+		return "", &ExitError{
+			Code: ExitTimeout,
+			Err:  fmt.Errorf("%w waiting for service instance %s. Last status: %s", err, serviceInstanceName, w.status),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if key, err := serviceutil.ServerKeyFor(cliConnection, org, space, serviceInstanceName); err == nil {
+		_ = serverCache.Put(key, *w.server)
+	}
+	return fmt.Sprintf("Service instance %s is ready: dataflow server %s\n", serviceInstanceName, w.server.DataflowUrl), nil
+}
+
+// DataflowServerWait waits, polling at the given interval, until the dataflow server at the given URL responds to
+// /about and, if it exposes /management/health, reports that it is up. Progress is written to the given writer
+// whenever the status changes. If the server is not ready within the given timeout, the error is an *ExitError with
+// code ExitTimeout.
+func DataflowServerWait(ctx context.Context, authClient httpclient.AuthenticatedClient, accessToken string, dataflowUrl string, timeout time.Duration, pollInterval time.Duration, progressWriter io.Writer) (string, error) {
+	w := &serviceWaiter{
+		authClient:     authClient,
+		accessToken:    accessToken,
+		progressWriter: progressWriter,
+		server:         &serviceutil.CachedServer{ServerUrls: serviceutil.ServerUrls{DataflowUrl: dataflowUrl}},
+	}
+
+	err := pluginutil.Poll(ctx, timeout, pollInterval, w.pollServer)
+	var timeoutError *pluginutil.TimeoutError
+	if errors.As(err, &timeoutError) {
+		return "", &ExitError{
+			Code: ExitTimeout,
+			Err:  fmt.Errorf("%w waiting for dataflow server %s. Last status: %s", err, dataflowUrl, w.status),
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Dataflow server %s is ready\n", dataflowUrl), nil
+}
+
+type serviceWaiter struct {
+	cliConnection       plugin.CliConnection
+	authClient          httpclient.AuthenticatedClient
+	accessToken         string
+	org                 string
+	space               string
+	serviceInstanceName string
+	progressWriter      io.Writer
+
+	server *serviceutil.CachedServer
+	status string
+}
+
+// report writes the given status unless it is the same as the previous status.
+func (w *serviceWaiter) report(format string, a ...interface{}) {
+	status := fmt.Sprintf(format, a...)
+	if status != w.status {
+		fmt.Fprintln(w.progressWriter, status)
+		w.status = status
+	}
+}
+
+func (w *serviceWaiter) poll(ctx context.Context) (bool, error) {
+	serviceInstance, err := serviceutil.FindServiceInstance(w.cliConnection, w.org, w.space, w.serviceInstanceName)
+	if err != nil {
+		return false, err
+	}
+
+	lastOperation := serviceInstance.Operation
+	switch lastOperation.State {
+	case operationInProgress:
+		w.report("Service instance %s %s", lastOperation.Type, operationInProgress)
+		return false, nil
+	case operationFailed:
+		return false, &ExitError{Code: ExitFailed, Err: fmt.Errorf("Service instance %s failed: %s", lastOperation.Type, lastOperation.Description)}
+	}
+
+	if w.server == nil {
+		serverUrls, err := serviceutil.ResolveServiceInstanceUrls(ctx, w.cliConnection, serviceInstance, w.accessToken, w.authClient)
+		if err != nil {
+			w.report("Waiting for dataflow server URL: %s", err)
+			return false, nil
+		}
+		w.server = &serviceutil.CachedServer{ServerUrls: *serverUrls}
+	}
+
+	return w.pollServer(ctx)
+}
+
+// pollServer checks whether the dataflow server, whose URL is known, is ready.
+func (w *serviceWaiter) pollServer(ctx context.Context) (bool, error) {
+	about, err := dataflow.FetchAbout(ctx, w.server.DataflowUrl, w.authClient, w.accessToken)
+	if err != nil {
+		w.report("Waiting for dataflow server %s: %s", format.Bold(format.Cyan(w.server.DataflowUrl)), err)
+		return false, nil
+	}
+
+	health, err := w.health(ctx)
+	if err != nil {
+		w.report("Waiting for dataflow server health: %s", err)
+		return false, nil
+	}
+	if health != "" && health != healthUp {
+		w.report("Waiting for dataflow server health: %s", health)
+		return false, nil
+	}
+
+	w.server.About = about
+	return true, nil
+}
+
+// health returns the status reported by the dataflow server's /management/health endpoint or, if the server does not
+// expose the endpoint to the user, the empty string.
+func (w *serviceWaiter) health(ctx context.Context) (string, error) {
+	body, statusCode, _, err := w.authClient.DoAuthenticatedGetContext(ctx, w.server.DataflowUrl+"/management/health", w.accessToken)
+	switch {
+	case statusCode == http.StatusNotFound, statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		// The endpoint is not exposed or is restricted to other users, so it cannot be waited for.
+		return "", nil
+	case statusCode == http.StatusServiceUnavailable:
+		// A server which is not up reports its health with a 503 status.
+		return "DOWN", nil
+	case err != nil:
+		return "", err
+	}
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(content, &health); err != nil {
+		return "", fmt.Errorf("Invalid health response JSON: %s", err)
+	}
+	return health.Status, nil
+}
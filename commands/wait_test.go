@@ -0,0 +1,337 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package commands_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil/serviceutilfakes"
+)
+
+var _ = Describe("DataflowWait", func() {
+	const (
+		dataflowUrl = "https://dataflow.example.com"
+		aboutUrl    = dataflowUrl + "/about"
+		healthUrl   = dataflowUrl + "/management/health"
+	)
+
+	type response struct {
+		statusCode int
+		body       string
+	}
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		fakeAuthClient    *httpclientfakes.FakeAuthenticatedClient
+		fakeServerCache   *serviceutilfakes.FakeServerCache
+		org               string
+		space             string
+		lastOperations    []plugin_models.GetService_LastOperation
+		responses         map[string][]response
+		timeout           time.Duration
+		progress          *bytes.Buffer
+		output            string
+		err               error
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		lastOperations = []plugin_models.GetService_LastOperation{
+			{Type: "create", State: "in progress"},
+			{Type: "create", State: "in progress"},
+			{Type: "create", State: "succeeded"},
+		}
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.ApiEndpointReturns("https://api.example.com", nil)
+		fakeCliConnection.GetCurrentOrgReturns(plugin_models.Organization{OrganizationFields: plugin_models.OrganizationFields{Name: "org"}}, nil)
+		fakeCliConnection.GetCurrentSpaceReturns(plugin_models.Space{SpaceFields: plugin_models.SpaceFields{Name: "space"}}, nil)
+		fakeCliConnection.GetServiceStub = func(name string) (plugin_models.GetService_Model, error) {
+			lastOperation := lastOperations[0]
+			if len(lastOperations) > 1 {
+				lastOperations = lastOperations[1:]
+			}
+			return plugin_models.GetService_Model{Guid: "guid", Name: name, LastOperation: lastOperation}, nil
+		}
+		fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+			if args[1] == "/v2/service_instances/guid/service_keys" {
+				return []string{`{"resources": [{"entity": {"credentials": {"dataflow-url": "` + dataflowUrl + `"}}}]}`}, nil
+			}
+			return nil, errors.New("unexpected request " + args[1])
+		}
+
+		responses = map[string][]response{
+			aboutUrl:  {{http.StatusOK, `{"versionInfo": {"core": {"version": "2.1.0.RELEASE"}}}`}},
+			healthUrl: {{http.StatusOK, `{"status": "UP"}`}},
+		}
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedGetContextStub = func(ctx context.Context, url string, token string) (io.ReadCloser, int, http.Header, error) {
+			rs := responses[url]
+			if len(rs) == 0 {
+				return nil, 0, nil, errors.New("connection refused")
+			}
+			r := rs[0]
+			if len(rs) > 1 {
+				responses[url] = rs[1:]
+			}
+			if r.statusCode != http.StatusOK {
+				return nil, r.statusCode, nil, errors.New(http.StatusText(r.statusCode))
+			}
+			return ioutil.NopCloser(strings.NewReader(r.body)), r.statusCode, nil, nil
+		}
+
+		fakeServerCache = &serviceutilfakes.FakeServerCache{}
+		org = ""
+		space = ""
+		timeout = time.Second
+		progress = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.DataflowWait(context.Background(), fakeCliConnection, fakeServerCache, fakeAuthClient, "token", org, space, "dataflow", timeout, time.Millisecond, progress)
+	})
+
+	It("should wait until the service instance is ready", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("Service instance dataflow is ready: dataflow server https://dataflow.example.com\n"))
+		Expect(fakeCliConnection.GetServiceCallCount()).To(Equal(3))
+	})
+
+	It("should report each status once", func() {
+		Expect(progress.String()).To(Equal("Service instance create in progress\n"))
+	})
+
+	It("should cache the server", func() {
+		Expect(fakeServerCache.PutCallCount()).To(Equal(1))
+		key, server := fakeServerCache.PutArgsForCall(0)
+		Expect(key.Org).To(Equal("org"))
+		Expect(key.Space).To(Equal("space"))
+		Expect(key.ServiceInstance).To(Equal("dataflow"))
+		Expect(server.DataflowUrl).To(Equal(dataflowUrl))
+		Expect(server.About).NotTo(BeNil())
+	})
+
+	Context("when the service instance is in another space", func() {
+		BeforeEach(func() {
+			org = "other-org"
+			space = "other-space"
+			fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+				switch args[1] {
+				case "/v2/organizations?q=name%3Aother-org":
+					return []string{`{"resources": [{"metadata": {"guid": "org-guid"}}]}`}, nil
+				case "/v2/organizations/org-guid/spaces?q=name%3Aother-space":
+					return []string{`{"resources": [{"metadata": {"guid": "space-guid"}}]}`}, nil
+				case "/v2/spaces/space-guid/service_instances?return_user_provided_service_instances=true&q=name%3Adataflow":
+					lastOperation := lastOperations[0]
+					if len(lastOperations) > 1 {
+						lastOperations = lastOperations[1:]
+					}
+					return []string{`{"resources": [{"metadata": {"guid": "guid"}, "entity": {"name": "dataflow", "last_operation": ` +
+						`{"type": "` + lastOperation.Type + `", "state": "` + lastOperation.State + `"}}}]}`}, nil
+				case "/v2/service_instances/guid/service_keys":
+					return []string{`{"resources": [{"entity": {"credentials": {"dataflow-url": "` + dataflowUrl + `"}}}]}`}, nil
+				}
+				return nil, errors.New("unexpected request " + args[1])
+			}
+		})
+
+		It("should wait until the service instance is ready", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("Service instance dataflow is ready: dataflow server https://dataflow.example.com\n"))
+			Expect(progress.String()).To(Equal("Service instance create in progress\n"))
+			Expect(fakeCliConnection.GetServiceCallCount()).To(Equal(0))
+		})
+
+		It("should cache the server under the org and space", func() {
+			Expect(fakeServerCache.PutCallCount()).To(Equal(1))
+			key, _ := fakeServerCache.PutArgsForCall(0)
+			Expect(key.Org).To(Equal("other-org"))
+			Expect(key.Space).To(Equal("other-space"))
+			Expect(key.ServiceInstance).To(Equal("dataflow"))
+		})
+	})
+
+	Context("when the dataflow server is not yet reachable", func() {
+		BeforeEach(func() {
+			responses[aboutUrl] = append([]response{{http.StatusBadGateway, ""}}, responses[aboutUrl]...)
+		})
+
+		It("should wait for the dataflow server", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.String()).To(ContainSubstring("Waiting for dataflow server https://dataflow.example.com: Dataflow server error: Bad Gateway\n"))
+		})
+	})
+
+	Context("when the dataflow server is not yet healthy", func() {
+		BeforeEach(func() {
+			responses[healthUrl] = []response{{http.StatusServiceUnavailable, ""}, {http.StatusOK, `{"status": "UP"}`}}
+		})
+
+		It("should wait for the dataflow server to be up", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.String()).To(HaveSuffix("Waiting for dataflow server health: DOWN\n"))
+		})
+	})
+
+	Context("when the dataflow server does not expose its health", func() {
+		BeforeEach(func() {
+			responses[healthUrl] = []response{{http.StatusNotFound, ""}}
+		})
+
+		It("should not wait for health", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the user is not authorized to see the dataflow server's health", func() {
+		BeforeEach(func() {
+			responses[healthUrl] = []response{{http.StatusUnauthorized, ""}}
+			timeout = 20 * time.Millisecond
+		})
+
+		It("should not wait for health", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the user is forbidden to see the dataflow server's health", func() {
+		BeforeEach(func() {
+			responses[healthUrl] = []response{{http.StatusForbidden, ""}}
+			timeout = 20 * time.Millisecond
+		})
+
+		It("should not wait for health", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the last operation fails", func() {
+		BeforeEach(func() {
+			lastOperations[2] = plugin_models.GetService_LastOperation{Type: "create", State: "failed", Description: "quota exceeded"}
+		})
+
+		It("should fail", func() {
+			Expect(err).To(MatchError("Service instance create failed: quota exceeded"))
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			Expect(exitError.Code).To(Equal(commands.ExitFailed))
+		})
+	})
+
+	Context("when the service instance is not ready in time", func() {
+		BeforeEach(func() {
+			lastOperations = lastOperations[:1]
+			timeout = 20 * time.Millisecond
+		})
+
+		It("should time out with the last status", func() {
+			var timeoutError *pluginutil.TimeoutError
+			Expect(errors.As(err, &timeoutError)).To(BeTrue())
+			Expect(err).To(MatchError("Timed out after 20ms waiting for service instance dataflow. Last status: Service instance create in progress"))
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			Expect(exitError.Code).To(Equal(commands.ExitTimeout))
+		})
+	})
+})
+
+var _ = Describe("DataflowServerWait", func() {
+	const dataflowUrl = "https://dataflow.example.com"
+
+	var (
+		fakeAuthClient *httpclientfakes.FakeAuthenticatedClient
+		aboutErrors    int
+		timeout        time.Duration
+		progress       *bytes.Buffer
+		output         string
+		err            error
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+
+		aboutErrors = 1
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedGetContextStub = func(ctx context.Context, url string, token string) (io.ReadCloser, int, http.Header, error) {
+			switch url {
+			case dataflowUrl + "/about":
+				if aboutErrors != 0 {
+					if aboutErrors > 0 {
+						aboutErrors--
+					}
+					return nil, http.StatusBadGateway, nil, errors.New("Bad Gateway")
+				}
+				return ioutil.NopCloser(strings.NewReader(`{"versionInfo": {"core": {"version": "2.1.0.RELEASE"}}}`)), http.StatusOK, nil, nil
+			case dataflowUrl + "/management/health":
+				return ioutil.NopCloser(strings.NewReader(`{"status": "UP"}`)), http.StatusOK, nil, nil
+			}
+			return nil, 0, nil, errors.New("connection refused")
+		}
+		timeout = time.Second
+		progress = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		output, err = commands.DataflowServerWait(context.Background(), fakeAuthClient, "token", dataflowUrl, timeout, time.Millisecond, progress)
+	})
+
+	It("should wait until the dataflow server is ready", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal("Dataflow server https://dataflow.example.com is ready\n"))
+		Expect(progress.String()).To(Equal("Waiting for dataflow server https://dataflow.example.com: Dataflow server error: Bad Gateway\n"))
+	})
+
+	Context("when the dataflow server is not ready in time", func() {
+		BeforeEach(func() {
+			aboutErrors = -1
+			timeout = 20 * time.Millisecond
+		})
+
+		It("should time out with the last status", func() {
+			var timeoutError *pluginutil.TimeoutError
+			Expect(errors.As(err, &timeoutError)).To(BeTrue())
+			Expect(err).To(MatchError("Timed out after 20ms waiting for dataflow server https://dataflow.example.com. Last status: " +
+				"Waiting for dataflow server https://dataflow.example.com: Dataflow server error: Bad Gateway"))
+			var exitError *commands.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			Expect(exitError.Code).To(Equal(commands.ExitTimeout))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import "context"
+
+// AboutInfo describes a dataflow server, its version, and its enabled features.
+type AboutInfo struct {
+	FeatureInfo        FeatureInfo        `json:"featureInfo"`
+	VersionInfo        VersionInfo        `json:"versionInfo"`
+	SecurityInfo       SecurityInfo       `json:"securityInfo"`
+	RuntimeEnvironment RuntimeEnvironment `json:"runtimeEnvironment"`
+}
+
+type FeatureInfo struct {
+	StreamsEnabled   bool `json:"streamsEnabled"`
+	TasksEnabled     bool `json:"tasksEnabled"`
+	SchedulesEnabled bool `json:"schedulesEnabled"`
+}
+
+type VersionInfo struct {
+	Implementation Dependency `json:"implementation"`
+	Core           Dependency `json:"core"`
+	Dashboard      Dependency `json:"dashboard"`
+	Shell          Dependency `json:"shell"`
+}
+
+// Dependency describes a component of a dataflow server. Checksums are provided only for downloadable components.
+type Dependency struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	Url            string `json:"url"`
+	ChecksumSha1   string `json:"checksumSha1"`
+	ChecksumSha256 string `json:"checksumSha256"`
+}
+
+type SecurityInfo struct {
+	AuthenticationEnabled bool     `json:"authenticationEnabled"`
+	Authenticated         bool     `json:"authenticated"`
+	Username              string   `json:"username"`
+	Roles                 []string `json:"roles"`
+}
+
+// RuntimeEnvironment describes the deployers used for streams and the launchers used for tasks. When streams are
+// deployed using Skipper, the app deployer describes the Skipper server.
+type RuntimeEnvironment struct {
+	AppDeployer   RuntimeEnvironmentDetails   `json:"appDeployer"`
+	TaskLaunchers []RuntimeEnvironmentDetails `json:"taskLaunchers"`
+}
+
+type RuntimeEnvironmentDetails struct {
+	DeployerImplementationVersion string            `json:"deployerImplementationVersion"`
+	DeployerName                  string            `json:"deployerName"`
+	DeployerSpiVersion            string            `json:"deployerSpiVersion"`
+	JavaVersion                   string            `json:"javaVersion"`
+	PlatformApiVersion            string            `json:"platformApiVersion"`
+	PlatformClientVersion         string            `json:"platformClientVersion"`
+	PlatformHostVersion           string            `json:"platformHostVersion"`
+	PlatformType                  string            `json:"platformType"`
+	PlatformSpecificInfo          map[string]string `json:"platformSpecificInfo"`
+	SpringBootVersion             string            `json:"springBootVersion"`
+	SpringVersion                 string            `json:"springVersion"`
+}
+
+func (c *client) About(ctx context.Context) (*AboutInfo, error) {
+	var about AboutInfo
+	if err := c.api.Get(ctx, "about", nil, &about); err != nil {
+		return nil, err
+	}
+	return &about, nil
+}
+
+func (c *client) SecurityInfo(ctx context.Context) (*SecurityInfo, error) {
+	var securityInfo SecurityInfo
+	if err := c.api.Get(ctx, "security/info", nil, &securityInfo); err != nil {
+		return nil, err
+	}
+	return &securityInfo, nil
+}
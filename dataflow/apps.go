@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// AppRegistration is a registered application. Its type is one of "app", "source", "processor", "sink", or "task".
+type AppRegistration struct {
+	hal.Resource
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Uri            string `json:"uri"`
+	Version        string `json:"version"`
+	DefaultVersion bool   `json:"defaultVersion"`
+}
+
+func (c *client) Apps(ctx context.Context, appType string) ([]AppRegistration, error) {
+	href, err := c.api.Href(ctx, "apps", nil)
+	if err != nil {
+		return nil, err
+	}
+	if appType != "" {
+		href = addQuery(href, "type", appType)
+	}
+	apps := []AppRegistration{}
+	return apps, c.hal.Collect(ctx, href, "", &apps)
+}
+
+func (c *client) App(ctx context.Context, appType string, name string) (*AppRegistration, error) {
+	var app AppRegistration
+	if err := c.api.Get(ctx, "apps/app", map[string]string{"type": appType, "name": name}, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+func (c *client) RegisterApp(ctx context.Context, appType string, name string, uri string, force bool) error {
+	return c.api.Send(ctx, http.MethodPost, "apps/app", map[string]string{"type": appType, "name": name}, func(request *httpclient.Request) {
+		request.WithQuery("uri", uri)
+		if force {
+			request.WithQuery("force", "true")
+		}
+	}, nil)
+}
+
+func (c *client) UnregisterApp(ctx context.Context, appType string, name string) error {
+	return c.api.Delete(ctx, "apps/app", map[string]string{"type": appType, "name": name})
+}
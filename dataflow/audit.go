@@ -0,0 +1,44 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+// AuditRecord records an operation, such as the deployment of a stream, performed by the server.
+type AuditRecord struct {
+	hal.Resource
+	AuditRecordId  int64    `json:"auditRecordId"`
+	CreatedBy      string   `json:"createdBy"`
+	CorrelationId  string   `json:"correlationId"`
+	AuditData      string   `json:"auditData"`
+	CreatedOn      hal.Time `json:"createdOn"`
+	AuditAction    string   `json:"auditAction"`
+	AuditOperation string   `json:"auditOperation"`
+	PlatformName   string   `json:"platformName"`
+}
+
+func (c *client) AuditRecords(ctx context.Context) hal.Iterator {
+	href, err := c.api.Href(ctx, "audit-records", nil)
+	if err != nil {
+		return errorIterator{err}
+	}
+	return c.hal.Items(ctx, href, "")
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// Client is a client for the REST API of a Spring Cloud Data Flow server.
+//
+//go:generate counterfeiter -o dataflowfakes/fake_client.go . Client
+type Client interface {
+	// ServerUrl returns the URL of the dataflow server.
+	ServerUrl() string
+
+	// About returns information about the server, its version, and its enabled features.
+	About(ctx context.Context) (*AboutInfo, error)
+
+	// SecurityInfo returns information about the server's security and the authenticated user.
+	SecurityInfo(ctx context.Context) (*SecurityInfo, error)
+
+	// StreamDefinitions returns all stream definitions.
+	StreamDefinitions(ctx context.Context) ([]StreamDefinition, error)
+
+	// StreamDefinition returns the stream definition with the given name.
+	StreamDefinition(ctx context.Context, name string) (*StreamDefinition, error)
+
+	// CreateStream creates a stream definition and, if deploy is true, deploys the stream.
+	CreateStream(ctx context.Context, name string, definition string, description string, deploy bool) (*StreamDefinition, error)
+
+	// DestroyStream deletes the stream definition with the given name, undeploying the stream if necessary.
+	DestroyStream(ctx context.Context, name string) error
+
+	// StreamDeployment returns the deployment of the stream with the given name.
+	StreamDeployment(ctx context.Context, name string) (*StreamDeployment, error)
+
+	// DeployStream deploys the stream with the given name using the given deployment properties.
+	DeployStream(ctx context.Context, name string, properties map[string]string) error
+
+	// UndeployStream undeploys the stream with the given name.
+	UndeployStream(ctx context.Context, name string) error
+
+	// UpdateStream updates the deployed stream with the given name using the given deployment properties.
+	UpdateStream(ctx context.Context, name string, properties map[string]string) error
+
+	// RollbackStream rolls back the stream with the given name to the given release version, or to the previous
+	// version if version is zero.
+	RollbackStream(ctx context.Context, name string, version int) error
+
+	// StreamHistory returns the releases of the stream with the given name, most recent first.
+	StreamHistory(ctx context.Context, name string) ([]StreamRelease, error)
+
+	// StreamManifest returns the manifest of the given release version of the stream with the given name.
+	StreamManifest(ctx context.Context, name string, version int) (string, error)
+
+	// ScaleStreamApp scales the application with the given label in the stream with the given name to the given
+	// number of instances, applying the given deployment properties.
+	ScaleStreamApp(ctx context.Context, streamName string, appLabel string, count int, properties map[string]string) error
+
+	// StreamPlatforms returns the platforms to which streams may be deployed.
+	StreamPlatforms(ctx context.Context) ([]Platform, error)
+
+	// StreamRuntimeStatus returns the runtime status of the applications of the streams with the given names.
+	StreamRuntimeStatus(ctx context.Context, names ...string) ([]StreamStatus, error)
+
+	// RuntimeApps returns the runtime status of all deployed applications.
+	RuntimeApps(ctx context.Context) ([]AppStatus, error)
+
+	// RuntimeApp returns the runtime status of the deployed application with the given deployment ID.
+	RuntimeApp(ctx context.Context, deploymentId string) (*AppStatus, error)
+
+	// TaskDefinitions returns all task definitions.
+	TaskDefinitions(ctx context.Context) ([]TaskDefinition, error)
+
+	// TaskDefinition returns the task definition with the given name.
+	TaskDefinition(ctx context.Context, name string) (*TaskDefinition, error)
+
+	// CreateTask creates a task definition.
+	CreateTask(ctx context.Context, name string, definition string, description string) (*TaskDefinition, error)
+
+	// DestroyTask deletes the task definition with the given name and, if cleanup is true, cleans up the
+	// resources of its task executions.
+	DestroyTask(ctx context.Context, name string, cleanup bool) error
+
+	// LaunchTask launches the task with the given name and returns the ID of the task execution.
+	LaunchTask(ctx context.Context, name string, launch TaskLaunch) (int64, error)
+
+	// TaskExecutions returns an iterator over the task executions of the task with the given name or, if the name
+	// is empty, of all tasks, requesting pages of the given size or, if the size is zero, of the server's default
+	// size. Items are of type TaskExecution.
+	TaskExecutions(ctx context.Context, taskName string, pageSize int) hal.Iterator
+
+	// TaskExecutionPage returns the given page, numbered from zero, of the task executions of the task with the
+	// given name or, if the name is empty, of all tasks.
+	TaskExecutionPage(ctx context.Context, taskName string, page int, size int) (*TaskExecutionPage, error)
+
+	// TaskExecution returns the task execution with the given ID.
+	TaskExecution(ctx context.Context, id int64) (*TaskExecution, error)
+
+	// CleanupTaskExecution releases the platform resources of the task execution with the given ID and, if
+	// removeData is true, deletes its data.
+	CleanupTaskExecution(ctx context.Context, id int64, removeData bool) error
+
+	// TaskLog returns the log of the task execution with the given external execution ID on the given platform.
+	// The platform may be empty to use the default platform.
+	TaskLog(ctx context.Context, externalExecutionId string, platform string) (string, error)
+
+	// TaskPlatforms returns the platforms on which tasks may be launched.
+	TaskPlatforms(ctx context.Context) ([]Platform, error)
+
+	// JobExecutions returns the job executions of the job with the given name or, if the name is empty, of all jobs.
+	JobExecutions(ctx context.Context, jobName string) ([]JobExecution, error)
+
+	// JobExecution returns the job execution with the given ID.
+	JobExecution(ctx context.Context, id int64) (*JobExecution, error)
+
+	// RestartJobExecution restarts the job execution with the given ID.
+	RestartJobExecution(ctx context.Context, id int64) error
+
+	// StopJobExecution stops the job execution with the given ID.
+	StopJobExecution(ctx context.Context, id int64) error
+
+	// Apps returns the registered applications of the given type or, if the type is empty, of all types.
+	Apps(ctx context.Context, appType string) ([]AppRegistration, error)
+
+	// App returns the registered application with the given type and name.
+	App(ctx context.Context, appType string, name string) (*AppRegistration, error)
+
+	// RegisterApp registers an application with the given type, name, and URI, replacing any existing
+	// registration if force is true.
+	RegisterApp(ctx context.Context, appType string, name string, uri string, force bool) error
+
+	// UnregisterApp unregisters the application with the given type and name.
+	UnregisterApp(ctx context.Context, appType string, name string) error
+
+	// Schedules returns the task schedules for the task with the given name or, if the name is empty, for all
+	// tasks.
+	Schedules(ctx context.Context, taskName string) ([]Schedule, error)
+
+	// CreateSchedule schedules the task with the given name.
+	CreateSchedule(ctx context.Context, scheduleName string, taskName string, launch TaskLaunch) error
+
+	// DeleteSchedule deletes the schedule with the given name.
+	DeleteSchedule(ctx context.Context, scheduleName string) error
+
+	// AuditRecords returns an iterator over the server's audit records. Items are of type AuditRecord.
+	AuditRecords(ctx context.Context) hal.Iterator
+}
+
+// links maps the relations of links in the server's root resource to the URI templates used by servers whose
+// root resource does not provide the link.
+var links = map[string]string{
+	"about":                             "/about",
+	"security/info":                     "/security/info",
+	"streams/definitions":               "/streams/definitions",
+	"streams/definitions/definition":    "/streams/definitions/{name}",
+	"streams/deployments/deployment":    "/streams/deployments/{name}",
+	"streams/deployments/update":        "/streams/deployments/update/{name}",
+	"streams/deployments/rollback":      "/streams/deployments/rollback/{name}/{version}",
+	"streams/deployments/history":       "/streams/deployments/history/{name}",
+	"streams/deployments/manifest":      "/streams/deployments/manifest/{name}/{version}",
+	"streams/deployments/scale":         "/streams/deployments/scale/{streamName}/{appName}/instances/{count}",
+	"streams/deployments/platform/list": "/streams/deployments/platform/list",
+	"runtime/streams":                   "/runtime/streams{?names}",
+	"runtime/apps":                      "/runtime/apps",
+	"runtime/apps/app":                  "/runtime/apps/{appId}",
+	"tasks/definitions":                 "/tasks/definitions",
+	"tasks/definitions/definition":      "/tasks/definitions/{name}",
+	"tasks/executions":                  "/tasks/executions",
+	"tasks/executions/name":             "/tasks/executions{?name}",
+	"tasks/executions/execution":        "/tasks/executions/{id}",
+	"tasks/logs":                        "/tasks/logs/{taskExternalExecutionId}{?platformName}",
+	"tasks/platforms":                   "/tasks/platforms",
+	"tasks/schedules":                   "/tasks/schedules",
+	"tasks/schedules/instances":         "/tasks/schedules/instances/{taskDefinitionName}",
+	"tasks/schedules/schedule":          "/tasks/schedules/{scheduleName}",
+	"jobs/executions":                   "/jobs/executions",
+	"jobs/executions/name":              "/jobs/executions{?name}",
+	"jobs/executions/execution":         "/jobs/executions/{id}",
+	"apps":                              "/apps",
+	"apps/app":                          "/apps/{type}/{name}",
+	"audit-records":                     "/audit-records",
+}
+
+type client struct {
+	hal hal.Client
+	api *hal.Api
+}
+
+// NewClient returns a client for the dataflow server at the given URL.
+func NewClient(authClient httpclient.AuthenticatedClient, serverUrl string, accessToken string) *client {
+	return NewHalClient(hal.NewClient(authClient, serverUrl, accessToken))
+}
+
+// NewHalClient returns a client for the dataflow server accessed by the given HAL client.
+func NewHalClient(halClient hal.Client) *client {
+	return &client{hal: halClient, api: hal.NewApi(halClient, links)}
+}
+
+func (c *client) ServerUrl() string {
+	return c.hal.ServerUrl()
+}
+
+// errorIterator is an iterator which yields no items, only an error.
+type errorIterator struct {
+	err error
+}
+
+func (e errorIterator) Next(interface{}) bool {
+	return false
+}
+
+func (e errorIterator) Err() error {
+	return e.err
+}
+
+func (e errorIterator) Page() *hal.Page {
+	return nil
+}
+
+// formatProperties formats properties in the comma separated form expected by the server, in key order. As in the
+// dataflow shell, a value containing a comma is enclosed in double quotes so that the server does not split it.
+func formatProperties(properties map[string]string) string {
+	pairs := make([]string, 0, len(properties))
+	for _, key := range sortedKeys(properties) {
+		value := properties[key]
+		if strings.Contains(value, ",") {
+			value = `"` + value + `"`
+		}
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func itoa(i int) string {
+	return strconv.Itoa(i)
+}
+
+func formatId(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// addQuery returns the given URL with the given query parameter added.
+func addQuery(href string, name string, value string) string {
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	query := parsed.Query()
+	query.Add(name, value)
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func form(values ...string) url.Values {
+	form := url.Values{}
+	for i := 0; i+1 < len(values); i += 2 {
+		form.Set(values[i], values[i+1])
+	}
+	return form
+}
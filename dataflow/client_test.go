@@ -0,0 +1,308 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+)
+
+var _ = Describe("Client", func() {
+	const (
+		serverUrl   = "https://dataflow.example.com"
+		accessToken = "access-token"
+	)
+
+	var (
+		fakeAuthClient *httpclientfakes.FakeAuthenticatedClient
+		responses      map[string]string
+		requests       []*httpclient.Request
+		client         dataflow.Client
+		ctx            context.Context
+		err            error
+	)
+
+	lastRequest := func() *httpclient.Request {
+		Expect(requests).NotTo(BeEmpty())
+		return requests[len(requests)-1]
+	}
+
+	formOf := func(request *httpclient.Request) url.Values {
+		values, err := url.ParseQuery(request.Body())
+		Expect(err).NotTo(HaveOccurred())
+		return values
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = nil
+		responses = map[string]string{
+			"GET " + serverUrl + "/": `{"_links": {
+				"about": {"href": "https://dataflow.example.com/about"},
+				"streams/definitions": {"href": "https://dataflow.example.com/streams/definitions"},
+				"streams/definitions/definition": {"href": "https://dataflow.example.com/streams/definitions/{name}", "templated": true},
+				"tasks/executions": {"href": "https://dataflow.example.com/tasks/executions"},
+				"tasks/executions/name": {"href": "https://dataflow.example.com/tasks/executions{?name}", "templated": true},
+				"tasks/executions/execution": {"href": "https://dataflow.example.com/tasks/executions/{id}", "templated": true}
+			}}`,
+		}
+
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedStub = func(ctx context.Context, request *httpclient.Request, token string) (*http.Response, error) {
+			requests = append(requests, request)
+			body, ok := responses[request.Method()+" "+request.URL()]
+			if !ok {
+				return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"}, errors.New("not found: " + request.Method() + " " + request.URL())
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		client = dataflow.NewClient(fakeAuthClient, serverUrl, accessToken)
+	})
+
+	Describe("About", func() {
+		It("should return the server version and features", func() {
+			responses["GET "+serverUrl+"/about"] = `{
+				"featureInfo": {"streamsEnabled": true, "tasksEnabled": true, "schedulesEnabled": false},
+				"versionInfo": {"core": {"name": "Spring Cloud Data Flow Core", "version": "2.1.0.RELEASE"}}
+			}`
+
+			about, err := client.About(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(about.VersionInfo.Core.Version).To(Equal("2.1.0.RELEASE"))
+			Expect(about.FeatureInfo.StreamsEnabled).To(BeTrue())
+			Expect(about.FeatureInfo.SchedulesEnabled).To(BeFalse())
+		})
+
+		It("should propagate errors", func() {
+			_, err = client.About(ctx)
+			Expect(err).To(MatchError("not found: GET https://dataflow.example.com/about"))
+		})
+	})
+
+	Describe("link resolution", func() {
+		It("should fall back to the well-known path of a link missing from the root resource", func() {
+			responses["GET "+serverUrl+"/streams/deployments/ticktock"] = `{"streamName": "ticktock", "dslText": "time | log"}`
+
+			deployment, err := client.StreamDeployment(ctx, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.StreamName).To(Equal("ticktock"))
+		})
+	})
+
+	Describe("streams", func() {
+		It("should list stream definitions", func() {
+			responses["GET "+serverUrl+"/streams/definitions"] = `{"_embedded": {"streamDefinitionResourceList": [
+				{"name": "ticktock", "dslText": "time | log", "status": "deployed"},
+				{"name": "other", "dslText": "http | log", "status": "undeployed"}
+			]}}`
+
+			streams, err := client.StreamDefinitions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(streams).To(HaveLen(2))
+			Expect(streams[0].Name).To(Equal("ticktock"))
+			Expect(streams[0].DslText).To(Equal("time | log"))
+			Expect(streams[1].Status).To(Equal("undeployed"))
+		})
+
+		It("should create a stream using a form", func() {
+			responses["POST "+serverUrl+"/streams/definitions"] = `{"name": "ticktock", "dslText": "time | log"}`
+
+			stream, err := client.CreateStream(ctx, "ticktock", "time | log", "ticks", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stream.Name).To(Equal("ticktock"))
+
+			Expect(lastRequest().Header("Content-Type")).To(Equal(httpclient.ContentTypeForm))
+			values := formOf(lastRequest())
+			Expect(values.Get("name")).To(Equal("ticktock"))
+			Expect(values.Get("definition")).To(Equal("time | log"))
+			Expect(values.Get("description")).To(Equal("ticks"))
+			Expect(values.Get("deploy")).To(Equal("true"))
+		})
+
+		It("should deploy a stream with JSON properties", func() {
+			responses["POST "+serverUrl+"/streams/deployments/ticktock"] = ``
+
+			Expect(client.DeployStream(ctx, "ticktock", map[string]string{"app.log.count": "2"})).To(Succeed())
+			Expect(lastRequest().Body()).To(MatchJSON(`{"app.log.count": "2"}`))
+		})
+
+		It("should deploy a stream with no properties", func() {
+			responses["POST "+serverUrl+"/streams/deployments/ticktock"] = ``
+
+			Expect(client.DeployStream(ctx, "ticktock", nil)).To(Succeed())
+			Expect(lastRequest().Body()).To(MatchJSON(`{}`))
+		})
+
+		It("should return the stream history with the most recent version first", func() {
+			responses["GET "+serverUrl+"/streams/deployments/history/ticktock"] = `[
+				{"name": "ticktock", "version": 1, "info": {"status": {"statusCode": "DELETED"}}},
+				{"name": "ticktock", "version": 3, "info": {"status": {"statusCode": "DEPLOYED"}, "lastDeployed": "2019-02-01T10:00:00.000+0000"}},
+				{"name": "ticktock", "version": 2, "info": {"status": {"statusCode": "DELETED"}}}
+			]`
+
+			releases, err := client.StreamHistory(ctx, "ticktock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(3))
+			Expect(releases[0].Version).To(Equal(3))
+			Expect(releases[0].Info.Status.StatusCode).To(Equal("DEPLOYED"))
+			Expect(releases[0].Info.LastDeployed.Year()).To(Equal(2019))
+			Expect(releases[1].Version).To(Equal(2))
+			Expect(releases[2].Version).To(Equal(1))
+		})
+
+		It("should flatten the deployment properties of a stream", func() {
+			deployment := dataflow.StreamDeployment{
+				DeploymentProperties: `{"log": {"count": "2", "spring.cloud.deployer.memory": "1g"}, "time": {}}`,
+			}
+
+			properties, err := deployment.Properties()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(properties).To(Equal(map[string]string{
+				"log.count":                        "2",
+				"log.spring.cloud.deployer.memory": "1g",
+			}))
+		})
+	})
+
+	Describe("tasks", func() {
+		It("should launch a task and return the execution ID", func() {
+			responses["POST "+serverUrl+"/tasks/executions"] = `42`
+
+			id, err := client.LaunchTask(ctx, "timestamp", dataflow.TaskLaunch{
+				Arguments:  []string{"--a=1", "--b=2"},
+				Properties: map[string]string{"app.timestamp.format": "yyyy"},
+				Platform:   "cf",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(int64(42)))
+
+			values := formOf(lastRequest())
+			Expect(values.Get("name")).To(Equal("timestamp"))
+			Expect(values.Get("arguments")).To(Equal("--a=1 --b=2"))
+			Expect(values.Get("properties")).To(Equal("app.timestamp.format=yyyy," + dataflow.PlatformNameProperty + "=cf"))
+		})
+
+		It("should quote launch property values containing commas", func() {
+			responses["POST "+serverUrl+"/tasks/executions"] = `42`
+
+			_, err := client.LaunchTask(ctx, "timestamp", dataflow.TaskLaunch{
+				Properties: map[string]string{
+					"app.timestamp.format":        "yyyy",
+					"deployer.timestamp.services": "mysql,rabbit",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			values := formOf(lastRequest())
+			Expect(values.Get("properties")).To(Equal(`app.timestamp.format=yyyy,deployer.timestamp.services="mysql,rabbit"`))
+		})
+
+		It("should quote launch arguments containing whitespace", func() {
+			responses["POST "+serverUrl+"/tasks/executions"] = `42`
+
+			_, err := client.LaunchTask(ctx, "timestamp", dataflow.TaskLaunch{
+				Arguments: []string{"--a=1", "--greeting=hello world", "--b=2"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			values := formOf(lastRequest())
+			Expect(values.Get("arguments")).To(Equal(`--a=1 "--greeting=hello world" --b=2`))
+		})
+
+		It("should return a page of task executions", func() {
+			responses["GET "+serverUrl+"/tasks/executions?name=timestamp&page=1&size=2"] = `{
+				"_embedded": {"taskExecutionResourceList": [
+					{"executionId": 3, "taskName": "timestamp", "exitCode": 0, "taskExecutionStatus": "COMPLETE"},
+					{"executionId": 2, "taskName": "timestamp", "exitCode": null, "taskExecutionStatus": "RUNNING"}
+				]},
+				"page": {"size": 2, "totalElements": 4, "totalPages": 2, "number": 1}
+			}`
+
+			page, err := client.TaskExecutionPage(ctx, "timestamp", 1, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Page.TotalElements).To(Equal(4))
+			Expect(page.Executions).To(HaveLen(2))
+			Expect(page.Executions[0].ExecutionId).To(Equal(int64(3)))
+			Expect(*page.Executions[0].ExitCode).To(Equal(0))
+			Expect(page.Executions[1].ExitCode).To(BeNil())
+			Expect(page.Executions[1].TaskExecutionStatus).To(Equal(dataflow.TaskExecutionRunning))
+		})
+
+		It("should return an empty page of task executions", func() {
+			responses["GET "+serverUrl+"/tasks/executions?page=0&size=20"] = `{"page": {"size": 20, "totalElements": 0, "totalPages": 0, "number": 0}}`
+
+			page, err := client.TaskExecutionPage(ctx, "", 0, 20)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Executions).To(BeEmpty())
+		})
+
+		It("should iterate over task executions", func() {
+			responses["GET "+serverUrl+"/tasks/executions"] = `{"_embedded": {"taskExecutionResourceList": [
+				{"executionId": 2}, {"executionId": 1}
+			]}}`
+
+			iterator := client.TaskExecutions(ctx, "", 0)
+			ids := []int64{}
+			var execution dataflow.TaskExecution
+			for iterator.Next(&execution) {
+				ids = append(ids, execution.ExecutionId)
+			}
+			Expect(iterator.Err()).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int64{2, 1}))
+		})
+
+		It("should iterate over task executions in pages of the given size", func() {
+			responses["GET "+serverUrl+"/tasks/executions?size=500"] = `{"_embedded": {"taskExecutionResourceList": [
+				{"executionId": 2}, {"executionId": 1}
+			]}}`
+
+			iterator := client.TaskExecutions(ctx, "", 500)
+			ids := []int64{}
+			var execution dataflow.TaskExecution
+			for iterator.Next(&execution) {
+				ids = append(ids, execution.ExecutionId)
+			}
+			Expect(iterator.Err()).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int64{2, 1}))
+		})
+
+		It("should clean up a task execution and remove its data", func() {
+			responses["DELETE "+serverUrl+"/tasks/executions/7?action=CLEANUP%2CREMOVE_DATA"] = ``
+
+			Expect(client.CleanupTaskExecution(ctx, 7, true)).To(Succeed())
+		})
+
+		It("should return the log of a task execution as text", func() {
+			responses["GET "+serverUrl+"/tasks/logs/timestamp-abc?platformName=cf"] = `"line 1\nline 2\n"`
+
+			log, err := client.TaskLog(ctx, "timestamp-abc", "cf")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(log).To(Equal("line 1\nline 2\n"))
+		})
+	})
+})
@@ -0,0 +1,3381 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dataflowfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+type FakeClient struct {
+	AboutStub        func(context.Context) (*dataflow.AboutInfo, error)
+	aboutMutex       sync.RWMutex
+	aboutArgsForCall []struct {
+		arg1 context.Context
+	}
+	aboutReturns struct {
+		result1 *dataflow.AboutInfo
+		result2 error
+	}
+	aboutReturnsOnCall map[int]struct {
+		result1 *dataflow.AboutInfo
+		result2 error
+	}
+	AppStub        func(context.Context, string, string) (*dataflow.AppRegistration, error)
+	appMutex       sync.RWMutex
+	appArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	appReturns struct {
+		result1 *dataflow.AppRegistration
+		result2 error
+	}
+	appReturnsOnCall map[int]struct {
+		result1 *dataflow.AppRegistration
+		result2 error
+	}
+	AppsStub        func(context.Context, string) ([]dataflow.AppRegistration, error)
+	appsMutex       sync.RWMutex
+	appsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	appsReturns struct {
+		result1 []dataflow.AppRegistration
+		result2 error
+	}
+	appsReturnsOnCall map[int]struct {
+		result1 []dataflow.AppRegistration
+		result2 error
+	}
+	AuditRecordsStub        func(context.Context) hal.Iterator
+	auditRecordsMutex       sync.RWMutex
+	auditRecordsArgsForCall []struct {
+		arg1 context.Context
+	}
+	auditRecordsReturns struct {
+		result1 hal.Iterator
+	}
+	auditRecordsReturnsOnCall map[int]struct {
+		result1 hal.Iterator
+	}
+	CleanupTaskExecutionStub        func(context.Context, int64, bool) error
+	cleanupTaskExecutionMutex       sync.RWMutex
+	cleanupTaskExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int64
+		arg3 bool
+	}
+	cleanupTaskExecutionReturns struct {
+		result1 error
+	}
+	cleanupTaskExecutionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateScheduleStub        func(context.Context, string, string, dataflow.TaskLaunch) error
+	createScheduleMutex       sync.RWMutex
+	createScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 dataflow.TaskLaunch
+	}
+	createScheduleReturns struct {
+		result1 error
+	}
+	createScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateStreamStub        func(context.Context, string, string, string, bool) (*dataflow.StreamDefinition, error)
+	createStreamMutex       sync.RWMutex
+	createStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}
+	createStreamReturns struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}
+	createStreamReturnsOnCall map[int]struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}
+	CreateTaskStub        func(context.Context, string, string, string) (*dataflow.TaskDefinition, error)
+	createTaskMutex       sync.RWMutex
+	createTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	createTaskReturns struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}
+	createTaskReturnsOnCall map[int]struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}
+	DeleteScheduleStub        func(context.Context, string) error
+	deleteScheduleMutex       sync.RWMutex
+	deleteScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteScheduleReturns struct {
+		result1 error
+	}
+	deleteScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeployStreamStub        func(context.Context, string, map[string]string) error
+	deployStreamMutex       sync.RWMutex
+	deployStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	deployStreamReturns struct {
+		result1 error
+	}
+	deployStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DestroyStreamStub        func(context.Context, string) error
+	destroyStreamMutex       sync.RWMutex
+	destroyStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	destroyStreamReturns struct {
+		result1 error
+	}
+	destroyStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DestroyTaskStub        func(context.Context, string, bool) error
+	destroyTaskMutex       sync.RWMutex
+	destroyTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	destroyTaskReturns struct {
+		result1 error
+	}
+	destroyTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	JobExecutionStub        func(context.Context, int64) (*dataflow.JobExecution, error)
+	jobExecutionMutex       sync.RWMutex
+	jobExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int64
+	}
+	jobExecutionReturns struct {
+		result1 *dataflow.JobExecution
+		result2 error
+	}
+	jobExecutionReturnsOnCall map[int]struct {
+		result1 *dataflow.JobExecution
+		result2 error
+	}
+	JobExecutionsStub        func(context.Context, string) ([]dataflow.JobExecution, error)
+	jobExecutionsMutex       sync.RWMutex
+	jobExecutionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	jobExecutionsReturns struct {
+		result1 []dataflow.JobExecution
+		result2 error
+	}
+	jobExecutionsReturnsOnCall map[int]struct {
+		result1 []dataflow.JobExecution
+		result2 error
+	}
+	LaunchTaskStub        func(context.Context, string, dataflow.TaskLaunch) (int64, error)
+	launchTaskMutex       sync.RWMutex
+	launchTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 dataflow.TaskLaunch
+	}
+	launchTaskReturns struct {
+		result1 int64
+		result2 error
+	}
+	launchTaskReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RegisterAppStub        func(context.Context, string, string, string, bool) error
+	registerAppMutex       sync.RWMutex
+	registerAppArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}
+	registerAppReturns struct {
+		result1 error
+	}
+	registerAppReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestartJobExecutionStub        func(context.Context, int64) error
+	restartJobExecutionMutex       sync.RWMutex
+	restartJobExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int64
+	}
+	restartJobExecutionReturns struct {
+		result1 error
+	}
+	restartJobExecutionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollbackStreamStub        func(context.Context, string, int) error
+	rollbackStreamMutex       sync.RWMutex
+	rollbackStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	rollbackStreamReturns struct {
+		result1 error
+	}
+	rollbackStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RuntimeAppStub        func(context.Context, string) (*dataflow.AppStatus, error)
+	runtimeAppMutex       sync.RWMutex
+	runtimeAppArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	runtimeAppReturns struct {
+		result1 *dataflow.AppStatus
+		result2 error
+	}
+	runtimeAppReturnsOnCall map[int]struct {
+		result1 *dataflow.AppStatus
+		result2 error
+	}
+	RuntimeAppsStub        func(context.Context) ([]dataflow.AppStatus, error)
+	runtimeAppsMutex       sync.RWMutex
+	runtimeAppsArgsForCall []struct {
+		arg1 context.Context
+	}
+	runtimeAppsReturns struct {
+		result1 []dataflow.AppStatus
+		result2 error
+	}
+	runtimeAppsReturnsOnCall map[int]struct {
+		result1 []dataflow.AppStatus
+		result2 error
+	}
+	ScaleStreamAppStub        func(context.Context, string, string, int, map[string]string) error
+	scaleStreamAppMutex       sync.RWMutex
+	scaleStreamAppArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 map[string]string
+	}
+	scaleStreamAppReturns struct {
+		result1 error
+	}
+	scaleStreamAppReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SchedulesStub        func(context.Context, string) ([]dataflow.Schedule, error)
+	schedulesMutex       sync.RWMutex
+	schedulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	schedulesReturns struct {
+		result1 []dataflow.Schedule
+		result2 error
+	}
+	schedulesReturnsOnCall map[int]struct {
+		result1 []dataflow.Schedule
+		result2 error
+	}
+	SecurityInfoStub        func(context.Context) (*dataflow.SecurityInfo, error)
+	securityInfoMutex       sync.RWMutex
+	securityInfoArgsForCall []struct {
+		arg1 context.Context
+	}
+	securityInfoReturns struct {
+		result1 *dataflow.SecurityInfo
+		result2 error
+	}
+	securityInfoReturnsOnCall map[int]struct {
+		result1 *dataflow.SecurityInfo
+		result2 error
+	}
+	ServerUrlStub        func() string
+	serverUrlMutex       sync.RWMutex
+	serverUrlArgsForCall []struct {
+	}
+	serverUrlReturns struct {
+		result1 string
+	}
+	serverUrlReturnsOnCall map[int]struct {
+		result1 string
+	}
+	StopJobExecutionStub        func(context.Context, int64) error
+	stopJobExecutionMutex       sync.RWMutex
+	stopJobExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int64
+	}
+	stopJobExecutionReturns struct {
+		result1 error
+	}
+	stopJobExecutionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StreamDefinitionStub        func(context.Context, string) (*dataflow.StreamDefinition, error)
+	streamDefinitionMutex       sync.RWMutex
+	streamDefinitionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	streamDefinitionReturns struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}
+	streamDefinitionReturnsOnCall map[int]struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}
+	StreamDefinitionsStub        func(context.Context) ([]dataflow.StreamDefinition, error)
+	streamDefinitionsMutex       sync.RWMutex
+	streamDefinitionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	streamDefinitionsReturns struct {
+		result1 []dataflow.StreamDefinition
+		result2 error
+	}
+	streamDefinitionsReturnsOnCall map[int]struct {
+		result1 []dataflow.StreamDefinition
+		result2 error
+	}
+	StreamDeploymentStub        func(context.Context, string) (*dataflow.StreamDeployment, error)
+	streamDeploymentMutex       sync.RWMutex
+	streamDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	streamDeploymentReturns struct {
+		result1 *dataflow.StreamDeployment
+		result2 error
+	}
+	streamDeploymentReturnsOnCall map[int]struct {
+		result1 *dataflow.StreamDeployment
+		result2 error
+	}
+	StreamHistoryStub        func(context.Context, string) ([]dataflow.StreamRelease, error)
+	streamHistoryMutex       sync.RWMutex
+	streamHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	streamHistoryReturns struct {
+		result1 []dataflow.StreamRelease
+		result2 error
+	}
+	streamHistoryReturnsOnCall map[int]struct {
+		result1 []dataflow.StreamRelease
+		result2 error
+	}
+	StreamManifestStub        func(context.Context, string, int) (string, error)
+	streamManifestMutex       sync.RWMutex
+	streamManifestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	streamManifestReturns struct {
+		result1 string
+		result2 error
+	}
+	streamManifestReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	StreamPlatformsStub        func(context.Context) ([]dataflow.Platform, error)
+	streamPlatformsMutex       sync.RWMutex
+	streamPlatformsArgsForCall []struct {
+		arg1 context.Context
+	}
+	streamPlatformsReturns struct {
+		result1 []dataflow.Platform
+		result2 error
+	}
+	streamPlatformsReturnsOnCall map[int]struct {
+		result1 []dataflow.Platform
+		result2 error
+	}
+	StreamRuntimeStatusStub        func(context.Context, ...string) ([]dataflow.StreamStatus, error)
+	streamRuntimeStatusMutex       sync.RWMutex
+	streamRuntimeStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+	}
+	streamRuntimeStatusReturns struct {
+		result1 []dataflow.StreamStatus
+		result2 error
+	}
+	streamRuntimeStatusReturnsOnCall map[int]struct {
+		result1 []dataflow.StreamStatus
+		result2 error
+	}
+	TaskDefinitionStub        func(context.Context, string) (*dataflow.TaskDefinition, error)
+	taskDefinitionMutex       sync.RWMutex
+	taskDefinitionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	taskDefinitionReturns struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}
+	taskDefinitionReturnsOnCall map[int]struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}
+	TaskDefinitionsStub        func(context.Context) ([]dataflow.TaskDefinition, error)
+	taskDefinitionsMutex       sync.RWMutex
+	taskDefinitionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	taskDefinitionsReturns struct {
+		result1 []dataflow.TaskDefinition
+		result2 error
+	}
+	taskDefinitionsReturnsOnCall map[int]struct {
+		result1 []dataflow.TaskDefinition
+		result2 error
+	}
+	TaskExecutionStub        func(context.Context, int64) (*dataflow.TaskExecution, error)
+	taskExecutionMutex       sync.RWMutex
+	taskExecutionArgsForCall []struct {
+		arg1 context.Context
+		arg2 int64
+	}
+	taskExecutionReturns struct {
+		result1 *dataflow.TaskExecution
+		result2 error
+	}
+	taskExecutionReturnsOnCall map[int]struct {
+		result1 *dataflow.TaskExecution
+		result2 error
+	}
+	TaskExecutionPageStub        func(context.Context, string, int, int) (*dataflow.TaskExecutionPage, error)
+	taskExecutionPageMutex       sync.RWMutex
+	taskExecutionPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	taskExecutionPageReturns struct {
+		result1 *dataflow.TaskExecutionPage
+		result2 error
+	}
+	taskExecutionPageReturnsOnCall map[int]struct {
+		result1 *dataflow.TaskExecutionPage
+		result2 error
+	}
+	TaskExecutionsStub        func(context.Context, string, int) hal.Iterator
+	taskExecutionsMutex       sync.RWMutex
+	taskExecutionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	taskExecutionsReturns struct {
+		result1 hal.Iterator
+	}
+	taskExecutionsReturnsOnCall map[int]struct {
+		result1 hal.Iterator
+	}
+	TaskLogStub        func(context.Context, string, string) (string, error)
+	taskLogMutex       sync.RWMutex
+	taskLogArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	taskLogReturns struct {
+		result1 string
+		result2 error
+	}
+	taskLogReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	TaskPlatformsStub        func(context.Context) ([]dataflow.Platform, error)
+	taskPlatformsMutex       sync.RWMutex
+	taskPlatformsArgsForCall []struct {
+		arg1 context.Context
+	}
+	taskPlatformsReturns struct {
+		result1 []dataflow.Platform
+		result2 error
+	}
+	taskPlatformsReturnsOnCall map[int]struct {
+		result1 []dataflow.Platform
+		result2 error
+	}
+	UndeployStreamStub        func(context.Context, string) error
+	undeployStreamMutex       sync.RWMutex
+	undeployStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	undeployStreamReturns struct {
+		result1 error
+	}
+	undeployStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnregisterAppStub        func(context.Context, string, string) error
+	unregisterAppMutex       sync.RWMutex
+	unregisterAppArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	unregisterAppReturns struct {
+		result1 error
+	}
+	unregisterAppReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStreamStub        func(context.Context, string, map[string]string) error
+	updateStreamMutex       sync.RWMutex
+	updateStreamArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	updateStreamReturns struct {
+		result1 error
+	}
+	updateStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) About(arg1 context.Context) (*dataflow.AboutInfo, error) {
+	fake.aboutMutex.Lock()
+	ret, specificReturn := fake.aboutReturnsOnCall[len(fake.aboutArgsForCall)]
+	fake.aboutArgsForCall = append(fake.aboutArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AboutStub
+	fakeReturns := fake.aboutReturns
+	fake.recordInvocation("About", []interface{}{arg1})
+	fake.aboutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AboutCallCount() int {
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	return len(fake.aboutArgsForCall)
+}
+
+func (fake *FakeClient) AboutCalls(stub func(context.Context) (*dataflow.AboutInfo, error)) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = stub
+}
+
+func (fake *FakeClient) AboutArgsForCall(i int) context.Context {
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	argsForCall := fake.aboutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) AboutReturns(result1 *dataflow.AboutInfo, result2 error) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = nil
+	fake.aboutReturns = struct {
+		result1 *dataflow.AboutInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AboutReturnsOnCall(i int, result1 *dataflow.AboutInfo, result2 error) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = nil
+	if fake.aboutReturnsOnCall == nil {
+		fake.aboutReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.AboutInfo
+			result2 error
+		})
+	}
+	fake.aboutReturnsOnCall[i] = struct {
+		result1 *dataflow.AboutInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) App(arg1 context.Context, arg2 string, arg3 string) (*dataflow.AppRegistration, error) {
+	fake.appMutex.Lock()
+	ret, specificReturn := fake.appReturnsOnCall[len(fake.appArgsForCall)]
+	fake.appArgsForCall = append(fake.appArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.AppStub
+	fakeReturns := fake.appReturns
+	fake.recordInvocation("App", []interface{}{arg1, arg2, arg3})
+	fake.appMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AppCallCount() int {
+	fake.appMutex.RLock()
+	defer fake.appMutex.RUnlock()
+	return len(fake.appArgsForCall)
+}
+
+func (fake *FakeClient) AppCalls(stub func(context.Context, string, string) (*dataflow.AppRegistration, error)) {
+	fake.appMutex.Lock()
+	defer fake.appMutex.Unlock()
+	fake.AppStub = stub
+}
+
+func (fake *FakeClient) AppArgsForCall(i int) (context.Context, string, string) {
+	fake.appMutex.RLock()
+	defer fake.appMutex.RUnlock()
+	argsForCall := fake.appArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) AppReturns(result1 *dataflow.AppRegistration, result2 error) {
+	fake.appMutex.Lock()
+	defer fake.appMutex.Unlock()
+	fake.AppStub = nil
+	fake.appReturns = struct {
+		result1 *dataflow.AppRegistration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AppReturnsOnCall(i int, result1 *dataflow.AppRegistration, result2 error) {
+	fake.appMutex.Lock()
+	defer fake.appMutex.Unlock()
+	fake.AppStub = nil
+	if fake.appReturnsOnCall == nil {
+		fake.appReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.AppRegistration
+			result2 error
+		})
+	}
+	fake.appReturnsOnCall[i] = struct {
+		result1 *dataflow.AppRegistration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Apps(arg1 context.Context, arg2 string) ([]dataflow.AppRegistration, error) {
+	fake.appsMutex.Lock()
+	ret, specificReturn := fake.appsReturnsOnCall[len(fake.appsArgsForCall)]
+	fake.appsArgsForCall = append(fake.appsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AppsStub
+	fakeReturns := fake.appsReturns
+	fake.recordInvocation("Apps", []interface{}{arg1, arg2})
+	fake.appsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AppsCallCount() int {
+	fake.appsMutex.RLock()
+	defer fake.appsMutex.RUnlock()
+	return len(fake.appsArgsForCall)
+}
+
+func (fake *FakeClient) AppsCalls(stub func(context.Context, string) ([]dataflow.AppRegistration, error)) {
+	fake.appsMutex.Lock()
+	defer fake.appsMutex.Unlock()
+	fake.AppsStub = stub
+}
+
+func (fake *FakeClient) AppsArgsForCall(i int) (context.Context, string) {
+	fake.appsMutex.RLock()
+	defer fake.appsMutex.RUnlock()
+	argsForCall := fake.appsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) AppsReturns(result1 []dataflow.AppRegistration, result2 error) {
+	fake.appsMutex.Lock()
+	defer fake.appsMutex.Unlock()
+	fake.AppsStub = nil
+	fake.appsReturns = struct {
+		result1 []dataflow.AppRegistration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AppsReturnsOnCall(i int, result1 []dataflow.AppRegistration, result2 error) {
+	fake.appsMutex.Lock()
+	defer fake.appsMutex.Unlock()
+	fake.AppsStub = nil
+	if fake.appsReturnsOnCall == nil {
+		fake.appsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.AppRegistration
+			result2 error
+		})
+	}
+	fake.appsReturnsOnCall[i] = struct {
+		result1 []dataflow.AppRegistration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AuditRecords(arg1 context.Context) hal.Iterator {
+	fake.auditRecordsMutex.Lock()
+	ret, specificReturn := fake.auditRecordsReturnsOnCall[len(fake.auditRecordsArgsForCall)]
+	fake.auditRecordsArgsForCall = append(fake.auditRecordsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AuditRecordsStub
+	fakeReturns := fake.auditRecordsReturns
+	fake.recordInvocation("AuditRecords", []interface{}{arg1})
+	fake.auditRecordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) AuditRecordsCallCount() int {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	return len(fake.auditRecordsArgsForCall)
+}
+
+func (fake *FakeClient) AuditRecordsCalls(stub func(context.Context) hal.Iterator) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = stub
+}
+
+func (fake *FakeClient) AuditRecordsArgsForCall(i int) context.Context {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	argsForCall := fake.auditRecordsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) AuditRecordsReturns(result1 hal.Iterator) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	fake.auditRecordsReturns = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) AuditRecordsReturnsOnCall(i int, result1 hal.Iterator) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	if fake.auditRecordsReturnsOnCall == nil {
+		fake.auditRecordsReturnsOnCall = make(map[int]struct {
+			result1 hal.Iterator
+		})
+	}
+	fake.auditRecordsReturnsOnCall[i] = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) CleanupTaskExecution(arg1 context.Context, arg2 int64, arg3 bool) error {
+	fake.cleanupTaskExecutionMutex.Lock()
+	ret, specificReturn := fake.cleanupTaskExecutionReturnsOnCall[len(fake.cleanupTaskExecutionArgsForCall)]
+	fake.cleanupTaskExecutionArgsForCall = append(fake.cleanupTaskExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int64
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.CleanupTaskExecutionStub
+	fakeReturns := fake.cleanupTaskExecutionReturns
+	fake.recordInvocation("CleanupTaskExecution", []interface{}{arg1, arg2, arg3})
+	fake.cleanupTaskExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CleanupTaskExecutionCallCount() int {
+	fake.cleanupTaskExecutionMutex.RLock()
+	defer fake.cleanupTaskExecutionMutex.RUnlock()
+	return len(fake.cleanupTaskExecutionArgsForCall)
+}
+
+func (fake *FakeClient) CleanupTaskExecutionCalls(stub func(context.Context, int64, bool) error) {
+	fake.cleanupTaskExecutionMutex.Lock()
+	defer fake.cleanupTaskExecutionMutex.Unlock()
+	fake.CleanupTaskExecutionStub = stub
+}
+
+func (fake *FakeClient) CleanupTaskExecutionArgsForCall(i int) (context.Context, int64, bool) {
+	fake.cleanupTaskExecutionMutex.RLock()
+	defer fake.cleanupTaskExecutionMutex.RUnlock()
+	argsForCall := fake.cleanupTaskExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CleanupTaskExecutionReturns(result1 error) {
+	fake.cleanupTaskExecutionMutex.Lock()
+	defer fake.cleanupTaskExecutionMutex.Unlock()
+	fake.CleanupTaskExecutionStub = nil
+	fake.cleanupTaskExecutionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CleanupTaskExecutionReturnsOnCall(i int, result1 error) {
+	fake.cleanupTaskExecutionMutex.Lock()
+	defer fake.cleanupTaskExecutionMutex.Unlock()
+	fake.CleanupTaskExecutionStub = nil
+	if fake.cleanupTaskExecutionReturnsOnCall == nil {
+		fake.cleanupTaskExecutionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cleanupTaskExecutionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CreateSchedule(arg1 context.Context, arg2 string, arg3 string, arg4 dataflow.TaskLaunch) error {
+	fake.createScheduleMutex.Lock()
+	ret, specificReturn := fake.createScheduleReturnsOnCall[len(fake.createScheduleArgsForCall)]
+	fake.createScheduleArgsForCall = append(fake.createScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 dataflow.TaskLaunch
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateScheduleStub
+	fakeReturns := fake.createScheduleReturns
+	fake.recordInvocation("CreateSchedule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CreateScheduleCallCount() int {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	return len(fake.createScheduleArgsForCall)
+}
+
+func (fake *FakeClient) CreateScheduleCalls(stub func(context.Context, string, string, dataflow.TaskLaunch) error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = stub
+}
+
+func (fake *FakeClient) CreateScheduleArgsForCall(i int) (context.Context, string, string, dataflow.TaskLaunch) {
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	argsForCall := fake.createScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) CreateScheduleReturns(result1 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	fake.createScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CreateScheduleReturnsOnCall(i int, result1 error) {
+	fake.createScheduleMutex.Lock()
+	defer fake.createScheduleMutex.Unlock()
+	fake.CreateScheduleStub = nil
+	if fake.createScheduleReturnsOnCall == nil {
+		fake.createScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CreateStream(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 bool) (*dataflow.StreamDefinition, error) {
+	fake.createStreamMutex.Lock()
+	ret, specificReturn := fake.createStreamReturnsOnCall[len(fake.createStreamArgsForCall)]
+	fake.createStreamArgsForCall = append(fake.createStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateStreamStub
+	fakeReturns := fake.createStreamReturns
+	fake.recordInvocation("CreateStream", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateStreamCallCount() int {
+	fake.createStreamMutex.RLock()
+	defer fake.createStreamMutex.RUnlock()
+	return len(fake.createStreamArgsForCall)
+}
+
+func (fake *FakeClient) CreateStreamCalls(stub func(context.Context, string, string, string, bool) (*dataflow.StreamDefinition, error)) {
+	fake.createStreamMutex.Lock()
+	defer fake.createStreamMutex.Unlock()
+	fake.CreateStreamStub = stub
+}
+
+func (fake *FakeClient) CreateStreamArgsForCall(i int) (context.Context, string, string, string, bool) {
+	fake.createStreamMutex.RLock()
+	defer fake.createStreamMutex.RUnlock()
+	argsForCall := fake.createStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClient) CreateStreamReturns(result1 *dataflow.StreamDefinition, result2 error) {
+	fake.createStreamMutex.Lock()
+	defer fake.createStreamMutex.Unlock()
+	fake.CreateStreamStub = nil
+	fake.createStreamReturns = struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateStreamReturnsOnCall(i int, result1 *dataflow.StreamDefinition, result2 error) {
+	fake.createStreamMutex.Lock()
+	defer fake.createStreamMutex.Unlock()
+	fake.CreateStreamStub = nil
+	if fake.createStreamReturnsOnCall == nil {
+		fake.createStreamReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.StreamDefinition
+			result2 error
+		})
+	}
+	fake.createStreamReturnsOnCall[i] = struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTask(arg1 context.Context, arg2 string, arg3 string, arg4 string) (*dataflow.TaskDefinition, error) {
+	fake.createTaskMutex.Lock()
+	ret, specificReturn := fake.createTaskReturnsOnCall[len(fake.createTaskArgsForCall)]
+	fake.createTaskArgsForCall = append(fake.createTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateTaskStub
+	fakeReturns := fake.createTaskReturns
+	fake.recordInvocation("CreateTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateTaskCallCount() int {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	return len(fake.createTaskArgsForCall)
+}
+
+func (fake *FakeClient) CreateTaskCalls(stub func(context.Context, string, string, string) (*dataflow.TaskDefinition, error)) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = stub
+}
+
+func (fake *FakeClient) CreateTaskArgsForCall(i int) (context.Context, string, string, string) {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	argsForCall := fake.createTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) CreateTaskReturns(result1 *dataflow.TaskDefinition, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	fake.createTaskReturns = struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTaskReturnsOnCall(i int, result1 *dataflow.TaskDefinition, result2 error) {
+	fake.createTaskMutex.Lock()
+	defer fake.createTaskMutex.Unlock()
+	fake.CreateTaskStub = nil
+	if fake.createTaskReturnsOnCall == nil {
+		fake.createTaskReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.TaskDefinition
+			result2 error
+		})
+	}
+	fake.createTaskReturnsOnCall[i] = struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteSchedule(arg1 context.Context, arg2 string) error {
+	fake.deleteScheduleMutex.Lock()
+	ret, specificReturn := fake.deleteScheduleReturnsOnCall[len(fake.deleteScheduleArgsForCall)]
+	fake.deleteScheduleArgsForCall = append(fake.deleteScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteScheduleStub
+	fakeReturns := fake.deleteScheduleReturns
+	fake.recordInvocation("DeleteSchedule", []interface{}{arg1, arg2})
+	fake.deleteScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteScheduleCallCount() int {
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	return len(fake.deleteScheduleArgsForCall)
+}
+
+func (fake *FakeClient) DeleteScheduleCalls(stub func(context.Context, string) error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = stub
+}
+
+func (fake *FakeClient) DeleteScheduleArgsForCall(i int) (context.Context, string) {
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	argsForCall := fake.deleteScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DeleteScheduleReturns(result1 error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = nil
+	fake.deleteScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteScheduleReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduleMutex.Lock()
+	defer fake.deleteScheduleMutex.Unlock()
+	fake.DeleteScheduleStub = nil
+	if fake.deleteScheduleReturnsOnCall == nil {
+		fake.deleteScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeployStream(arg1 context.Context, arg2 string, arg3 map[string]string) error {
+	fake.deployStreamMutex.Lock()
+	ret, specificReturn := fake.deployStreamReturnsOnCall[len(fake.deployStreamArgsForCall)]
+	fake.deployStreamArgsForCall = append(fake.deployStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.DeployStreamStub
+	fakeReturns := fake.deployStreamReturns
+	fake.recordInvocation("DeployStream", []interface{}{arg1, arg2, arg3})
+	fake.deployStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeployStreamCallCount() int {
+	fake.deployStreamMutex.RLock()
+	defer fake.deployStreamMutex.RUnlock()
+	return len(fake.deployStreamArgsForCall)
+}
+
+func (fake *FakeClient) DeployStreamCalls(stub func(context.Context, string, map[string]string) error) {
+	fake.deployStreamMutex.Lock()
+	defer fake.deployStreamMutex.Unlock()
+	fake.DeployStreamStub = stub
+}
+
+func (fake *FakeClient) DeployStreamArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.deployStreamMutex.RLock()
+	defer fake.deployStreamMutex.RUnlock()
+	argsForCall := fake.deployStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeployStreamReturns(result1 error) {
+	fake.deployStreamMutex.Lock()
+	defer fake.deployStreamMutex.Unlock()
+	fake.DeployStreamStub = nil
+	fake.deployStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeployStreamReturnsOnCall(i int, result1 error) {
+	fake.deployStreamMutex.Lock()
+	defer fake.deployStreamMutex.Unlock()
+	fake.DeployStreamStub = nil
+	if fake.deployStreamReturnsOnCall == nil {
+		fake.deployStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deployStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DestroyStream(arg1 context.Context, arg2 string) error {
+	fake.destroyStreamMutex.Lock()
+	ret, specificReturn := fake.destroyStreamReturnsOnCall[len(fake.destroyStreamArgsForCall)]
+	fake.destroyStreamArgsForCall = append(fake.destroyStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DestroyStreamStub
+	fakeReturns := fake.destroyStreamReturns
+	fake.recordInvocation("DestroyStream", []interface{}{arg1, arg2})
+	fake.destroyStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DestroyStreamCallCount() int {
+	fake.destroyStreamMutex.RLock()
+	defer fake.destroyStreamMutex.RUnlock()
+	return len(fake.destroyStreamArgsForCall)
+}
+
+func (fake *FakeClient) DestroyStreamCalls(stub func(context.Context, string) error) {
+	fake.destroyStreamMutex.Lock()
+	defer fake.destroyStreamMutex.Unlock()
+	fake.DestroyStreamStub = stub
+}
+
+func (fake *FakeClient) DestroyStreamArgsForCall(i int) (context.Context, string) {
+	fake.destroyStreamMutex.RLock()
+	defer fake.destroyStreamMutex.RUnlock()
+	argsForCall := fake.destroyStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DestroyStreamReturns(result1 error) {
+	fake.destroyStreamMutex.Lock()
+	defer fake.destroyStreamMutex.Unlock()
+	fake.DestroyStreamStub = nil
+	fake.destroyStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DestroyStreamReturnsOnCall(i int, result1 error) {
+	fake.destroyStreamMutex.Lock()
+	defer fake.destroyStreamMutex.Unlock()
+	fake.DestroyStreamStub = nil
+	if fake.destroyStreamReturnsOnCall == nil {
+		fake.destroyStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.destroyStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DestroyTask(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.destroyTaskMutex.Lock()
+	ret, specificReturn := fake.destroyTaskReturnsOnCall[len(fake.destroyTaskArgsForCall)]
+	fake.destroyTaskArgsForCall = append(fake.destroyTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DestroyTaskStub
+	fakeReturns := fake.destroyTaskReturns
+	fake.recordInvocation("DestroyTask", []interface{}{arg1, arg2, arg3})
+	fake.destroyTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DestroyTaskCallCount() int {
+	fake.destroyTaskMutex.RLock()
+	defer fake.destroyTaskMutex.RUnlock()
+	return len(fake.destroyTaskArgsForCall)
+}
+
+func (fake *FakeClient) DestroyTaskCalls(stub func(context.Context, string, bool) error) {
+	fake.destroyTaskMutex.Lock()
+	defer fake.destroyTaskMutex.Unlock()
+	fake.DestroyTaskStub = stub
+}
+
+func (fake *FakeClient) DestroyTaskArgsForCall(i int) (context.Context, string, bool) {
+	fake.destroyTaskMutex.RLock()
+	defer fake.destroyTaskMutex.RUnlock()
+	argsForCall := fake.destroyTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DestroyTaskReturns(result1 error) {
+	fake.destroyTaskMutex.Lock()
+	defer fake.destroyTaskMutex.Unlock()
+	fake.DestroyTaskStub = nil
+	fake.destroyTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DestroyTaskReturnsOnCall(i int, result1 error) {
+	fake.destroyTaskMutex.Lock()
+	defer fake.destroyTaskMutex.Unlock()
+	fake.DestroyTaskStub = nil
+	if fake.destroyTaskReturnsOnCall == nil {
+		fake.destroyTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.destroyTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) JobExecution(arg1 context.Context, arg2 int64) (*dataflow.JobExecution, error) {
+	fake.jobExecutionMutex.Lock()
+	ret, specificReturn := fake.jobExecutionReturnsOnCall[len(fake.jobExecutionArgsForCall)]
+	fake.jobExecutionArgsForCall = append(fake.jobExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.JobExecutionStub
+	fakeReturns := fake.jobExecutionReturns
+	fake.recordInvocation("JobExecution", []interface{}{arg1, arg2})
+	fake.jobExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) JobExecutionCallCount() int {
+	fake.jobExecutionMutex.RLock()
+	defer fake.jobExecutionMutex.RUnlock()
+	return len(fake.jobExecutionArgsForCall)
+}
+
+func (fake *FakeClient) JobExecutionCalls(stub func(context.Context, int64) (*dataflow.JobExecution, error)) {
+	fake.jobExecutionMutex.Lock()
+	defer fake.jobExecutionMutex.Unlock()
+	fake.JobExecutionStub = stub
+}
+
+func (fake *FakeClient) JobExecutionArgsForCall(i int) (context.Context, int64) {
+	fake.jobExecutionMutex.RLock()
+	defer fake.jobExecutionMutex.RUnlock()
+	argsForCall := fake.jobExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) JobExecutionReturns(result1 *dataflow.JobExecution, result2 error) {
+	fake.jobExecutionMutex.Lock()
+	defer fake.jobExecutionMutex.Unlock()
+	fake.JobExecutionStub = nil
+	fake.jobExecutionReturns = struct {
+		result1 *dataflow.JobExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) JobExecutionReturnsOnCall(i int, result1 *dataflow.JobExecution, result2 error) {
+	fake.jobExecutionMutex.Lock()
+	defer fake.jobExecutionMutex.Unlock()
+	fake.JobExecutionStub = nil
+	if fake.jobExecutionReturnsOnCall == nil {
+		fake.jobExecutionReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.JobExecution
+			result2 error
+		})
+	}
+	fake.jobExecutionReturnsOnCall[i] = struct {
+		result1 *dataflow.JobExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) JobExecutions(arg1 context.Context, arg2 string) ([]dataflow.JobExecution, error) {
+	fake.jobExecutionsMutex.Lock()
+	ret, specificReturn := fake.jobExecutionsReturnsOnCall[len(fake.jobExecutionsArgsForCall)]
+	fake.jobExecutionsArgsForCall = append(fake.jobExecutionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.JobExecutionsStub
+	fakeReturns := fake.jobExecutionsReturns
+	fake.recordInvocation("JobExecutions", []interface{}{arg1, arg2})
+	fake.jobExecutionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) JobExecutionsCallCount() int {
+	fake.jobExecutionsMutex.RLock()
+	defer fake.jobExecutionsMutex.RUnlock()
+	return len(fake.jobExecutionsArgsForCall)
+}
+
+func (fake *FakeClient) JobExecutionsCalls(stub func(context.Context, string) ([]dataflow.JobExecution, error)) {
+	fake.jobExecutionsMutex.Lock()
+	defer fake.jobExecutionsMutex.Unlock()
+	fake.JobExecutionsStub = stub
+}
+
+func (fake *FakeClient) JobExecutionsArgsForCall(i int) (context.Context, string) {
+	fake.jobExecutionsMutex.RLock()
+	defer fake.jobExecutionsMutex.RUnlock()
+	argsForCall := fake.jobExecutionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) JobExecutionsReturns(result1 []dataflow.JobExecution, result2 error) {
+	fake.jobExecutionsMutex.Lock()
+	defer fake.jobExecutionsMutex.Unlock()
+	fake.JobExecutionsStub = nil
+	fake.jobExecutionsReturns = struct {
+		result1 []dataflow.JobExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) JobExecutionsReturnsOnCall(i int, result1 []dataflow.JobExecution, result2 error) {
+	fake.jobExecutionsMutex.Lock()
+	defer fake.jobExecutionsMutex.Unlock()
+	fake.JobExecutionsStub = nil
+	if fake.jobExecutionsReturnsOnCall == nil {
+		fake.jobExecutionsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.JobExecution
+			result2 error
+		})
+	}
+	fake.jobExecutionsReturnsOnCall[i] = struct {
+		result1 []dataflow.JobExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) LaunchTask(arg1 context.Context, arg2 string, arg3 dataflow.TaskLaunch) (int64, error) {
+	fake.launchTaskMutex.Lock()
+	ret, specificReturn := fake.launchTaskReturnsOnCall[len(fake.launchTaskArgsForCall)]
+	fake.launchTaskArgsForCall = append(fake.launchTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 dataflow.TaskLaunch
+	}{arg1, arg2, arg3})
+	stub := fake.LaunchTaskStub
+	fakeReturns := fake.launchTaskReturns
+	fake.recordInvocation("LaunchTask", []interface{}{arg1, arg2, arg3})
+	fake.launchTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) LaunchTaskCallCount() int {
+	fake.launchTaskMutex.RLock()
+	defer fake.launchTaskMutex.RUnlock()
+	return len(fake.launchTaskArgsForCall)
+}
+
+func (fake *FakeClient) LaunchTaskCalls(stub func(context.Context, string, dataflow.TaskLaunch) (int64, error)) {
+	fake.launchTaskMutex.Lock()
+	defer fake.launchTaskMutex.Unlock()
+	fake.LaunchTaskStub = stub
+}
+
+func (fake *FakeClient) LaunchTaskArgsForCall(i int) (context.Context, string, dataflow.TaskLaunch) {
+	fake.launchTaskMutex.RLock()
+	defer fake.launchTaskMutex.RUnlock()
+	argsForCall := fake.launchTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) LaunchTaskReturns(result1 int64, result2 error) {
+	fake.launchTaskMutex.Lock()
+	defer fake.launchTaskMutex.Unlock()
+	fake.LaunchTaskStub = nil
+	fake.launchTaskReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) LaunchTaskReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.launchTaskMutex.Lock()
+	defer fake.launchTaskMutex.Unlock()
+	fake.LaunchTaskStub = nil
+	if fake.launchTaskReturnsOnCall == nil {
+		fake.launchTaskReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.launchTaskReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RegisterApp(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 bool) error {
+	fake.registerAppMutex.Lock()
+	ret, specificReturn := fake.registerAppReturnsOnCall[len(fake.registerAppArgsForCall)]
+	fake.registerAppArgsForCall = append(fake.registerAppArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RegisterAppStub
+	fakeReturns := fake.registerAppReturns
+	fake.recordInvocation("RegisterApp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.registerAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RegisterAppCallCount() int {
+	fake.registerAppMutex.RLock()
+	defer fake.registerAppMutex.RUnlock()
+	return len(fake.registerAppArgsForCall)
+}
+
+func (fake *FakeClient) RegisterAppCalls(stub func(context.Context, string, string, string, bool) error) {
+	fake.registerAppMutex.Lock()
+	defer fake.registerAppMutex.Unlock()
+	fake.RegisterAppStub = stub
+}
+
+func (fake *FakeClient) RegisterAppArgsForCall(i int) (context.Context, string, string, string, bool) {
+	fake.registerAppMutex.RLock()
+	defer fake.registerAppMutex.RUnlock()
+	argsForCall := fake.registerAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClient) RegisterAppReturns(result1 error) {
+	fake.registerAppMutex.Lock()
+	defer fake.registerAppMutex.Unlock()
+	fake.RegisterAppStub = nil
+	fake.registerAppReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RegisterAppReturnsOnCall(i int, result1 error) {
+	fake.registerAppMutex.Lock()
+	defer fake.registerAppMutex.Unlock()
+	fake.RegisterAppStub = nil
+	if fake.registerAppReturnsOnCall == nil {
+		fake.registerAppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.registerAppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartJobExecution(arg1 context.Context, arg2 int64) error {
+	fake.restartJobExecutionMutex.Lock()
+	ret, specificReturn := fake.restartJobExecutionReturnsOnCall[len(fake.restartJobExecutionArgsForCall)]
+	fake.restartJobExecutionArgsForCall = append(fake.restartJobExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.RestartJobExecutionStub
+	fakeReturns := fake.restartJobExecutionReturns
+	fake.recordInvocation("RestartJobExecution", []interface{}{arg1, arg2})
+	fake.restartJobExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RestartJobExecutionCallCount() int {
+	fake.restartJobExecutionMutex.RLock()
+	defer fake.restartJobExecutionMutex.RUnlock()
+	return len(fake.restartJobExecutionArgsForCall)
+}
+
+func (fake *FakeClient) RestartJobExecutionCalls(stub func(context.Context, int64) error) {
+	fake.restartJobExecutionMutex.Lock()
+	defer fake.restartJobExecutionMutex.Unlock()
+	fake.RestartJobExecutionStub = stub
+}
+
+func (fake *FakeClient) RestartJobExecutionArgsForCall(i int) (context.Context, int64) {
+	fake.restartJobExecutionMutex.RLock()
+	defer fake.restartJobExecutionMutex.RUnlock()
+	argsForCall := fake.restartJobExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RestartJobExecutionReturns(result1 error) {
+	fake.restartJobExecutionMutex.Lock()
+	defer fake.restartJobExecutionMutex.Unlock()
+	fake.RestartJobExecutionStub = nil
+	fake.restartJobExecutionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RestartJobExecutionReturnsOnCall(i int, result1 error) {
+	fake.restartJobExecutionMutex.Lock()
+	defer fake.restartJobExecutionMutex.Unlock()
+	fake.RestartJobExecutionStub = nil
+	if fake.restartJobExecutionReturnsOnCall == nil {
+		fake.restartJobExecutionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartJobExecutionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RollbackStream(arg1 context.Context, arg2 string, arg3 int) error {
+	fake.rollbackStreamMutex.Lock()
+	ret, specificReturn := fake.rollbackStreamReturnsOnCall[len(fake.rollbackStreamArgsForCall)]
+	fake.rollbackStreamArgsForCall = append(fake.rollbackStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackStreamStub
+	fakeReturns := fake.rollbackStreamReturns
+	fake.recordInvocation("RollbackStream", []interface{}{arg1, arg2, arg3})
+	fake.rollbackStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RollbackStreamCallCount() int {
+	fake.rollbackStreamMutex.RLock()
+	defer fake.rollbackStreamMutex.RUnlock()
+	return len(fake.rollbackStreamArgsForCall)
+}
+
+func (fake *FakeClient) RollbackStreamCalls(stub func(context.Context, string, int) error) {
+	fake.rollbackStreamMutex.Lock()
+	defer fake.rollbackStreamMutex.Unlock()
+	fake.RollbackStreamStub = stub
+}
+
+func (fake *FakeClient) RollbackStreamArgsForCall(i int) (context.Context, string, int) {
+	fake.rollbackStreamMutex.RLock()
+	defer fake.rollbackStreamMutex.RUnlock()
+	argsForCall := fake.rollbackStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RollbackStreamReturns(result1 error) {
+	fake.rollbackStreamMutex.Lock()
+	defer fake.rollbackStreamMutex.Unlock()
+	fake.RollbackStreamStub = nil
+	fake.rollbackStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RollbackStreamReturnsOnCall(i int, result1 error) {
+	fake.rollbackStreamMutex.Lock()
+	defer fake.rollbackStreamMutex.Unlock()
+	fake.RollbackStreamStub = nil
+	if fake.rollbackStreamReturnsOnCall == nil {
+		fake.rollbackStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rollbackStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RuntimeApp(arg1 context.Context, arg2 string) (*dataflow.AppStatus, error) {
+	fake.runtimeAppMutex.Lock()
+	ret, specificReturn := fake.runtimeAppReturnsOnCall[len(fake.runtimeAppArgsForCall)]
+	fake.runtimeAppArgsForCall = append(fake.runtimeAppArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RuntimeAppStub
+	fakeReturns := fake.runtimeAppReturns
+	fake.recordInvocation("RuntimeApp", []interface{}{arg1, arg2})
+	fake.runtimeAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RuntimeAppCallCount() int {
+	fake.runtimeAppMutex.RLock()
+	defer fake.runtimeAppMutex.RUnlock()
+	return len(fake.runtimeAppArgsForCall)
+}
+
+func (fake *FakeClient) RuntimeAppCalls(stub func(context.Context, string) (*dataflow.AppStatus, error)) {
+	fake.runtimeAppMutex.Lock()
+	defer fake.runtimeAppMutex.Unlock()
+	fake.RuntimeAppStub = stub
+}
+
+func (fake *FakeClient) RuntimeAppArgsForCall(i int) (context.Context, string) {
+	fake.runtimeAppMutex.RLock()
+	defer fake.runtimeAppMutex.RUnlock()
+	argsForCall := fake.runtimeAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RuntimeAppReturns(result1 *dataflow.AppStatus, result2 error) {
+	fake.runtimeAppMutex.Lock()
+	defer fake.runtimeAppMutex.Unlock()
+	fake.RuntimeAppStub = nil
+	fake.runtimeAppReturns = struct {
+		result1 *dataflow.AppStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RuntimeAppReturnsOnCall(i int, result1 *dataflow.AppStatus, result2 error) {
+	fake.runtimeAppMutex.Lock()
+	defer fake.runtimeAppMutex.Unlock()
+	fake.RuntimeAppStub = nil
+	if fake.runtimeAppReturnsOnCall == nil {
+		fake.runtimeAppReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.AppStatus
+			result2 error
+		})
+	}
+	fake.runtimeAppReturnsOnCall[i] = struct {
+		result1 *dataflow.AppStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RuntimeApps(arg1 context.Context) ([]dataflow.AppStatus, error) {
+	fake.runtimeAppsMutex.Lock()
+	ret, specificReturn := fake.runtimeAppsReturnsOnCall[len(fake.runtimeAppsArgsForCall)]
+	fake.runtimeAppsArgsForCall = append(fake.runtimeAppsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RuntimeAppsStub
+	fakeReturns := fake.runtimeAppsReturns
+	fake.recordInvocation("RuntimeApps", []interface{}{arg1})
+	fake.runtimeAppsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RuntimeAppsCallCount() int {
+	fake.runtimeAppsMutex.RLock()
+	defer fake.runtimeAppsMutex.RUnlock()
+	return len(fake.runtimeAppsArgsForCall)
+}
+
+func (fake *FakeClient) RuntimeAppsCalls(stub func(context.Context) ([]dataflow.AppStatus, error)) {
+	fake.runtimeAppsMutex.Lock()
+	defer fake.runtimeAppsMutex.Unlock()
+	fake.RuntimeAppsStub = stub
+}
+
+func (fake *FakeClient) RuntimeAppsArgsForCall(i int) context.Context {
+	fake.runtimeAppsMutex.RLock()
+	defer fake.runtimeAppsMutex.RUnlock()
+	argsForCall := fake.runtimeAppsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RuntimeAppsReturns(result1 []dataflow.AppStatus, result2 error) {
+	fake.runtimeAppsMutex.Lock()
+	defer fake.runtimeAppsMutex.Unlock()
+	fake.RuntimeAppsStub = nil
+	fake.runtimeAppsReturns = struct {
+		result1 []dataflow.AppStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RuntimeAppsReturnsOnCall(i int, result1 []dataflow.AppStatus, result2 error) {
+	fake.runtimeAppsMutex.Lock()
+	defer fake.runtimeAppsMutex.Unlock()
+	fake.RuntimeAppsStub = nil
+	if fake.runtimeAppsReturnsOnCall == nil {
+		fake.runtimeAppsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.AppStatus
+			result2 error
+		})
+	}
+	fake.runtimeAppsReturnsOnCall[i] = struct {
+		result1 []dataflow.AppStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScaleStreamApp(arg1 context.Context, arg2 string, arg3 string, arg4 int, arg5 map[string]string) error {
+	fake.scaleStreamAppMutex.Lock()
+	ret, specificReturn := fake.scaleStreamAppReturnsOnCall[len(fake.scaleStreamAppArgsForCall)]
+	fake.scaleStreamAppArgsForCall = append(fake.scaleStreamAppArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 map[string]string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ScaleStreamAppStub
+	fakeReturns := fake.scaleStreamAppReturns
+	fake.recordInvocation("ScaleStreamApp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.scaleStreamAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ScaleStreamAppCallCount() int {
+	fake.scaleStreamAppMutex.RLock()
+	defer fake.scaleStreamAppMutex.RUnlock()
+	return len(fake.scaleStreamAppArgsForCall)
+}
+
+func (fake *FakeClient) ScaleStreamAppCalls(stub func(context.Context, string, string, int, map[string]string) error) {
+	fake.scaleStreamAppMutex.Lock()
+	defer fake.scaleStreamAppMutex.Unlock()
+	fake.ScaleStreamAppStub = stub
+}
+
+func (fake *FakeClient) ScaleStreamAppArgsForCall(i int) (context.Context, string, string, int, map[string]string) {
+	fake.scaleStreamAppMutex.RLock()
+	defer fake.scaleStreamAppMutex.RUnlock()
+	argsForCall := fake.scaleStreamAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeClient) ScaleStreamAppReturns(result1 error) {
+	fake.scaleStreamAppMutex.Lock()
+	defer fake.scaleStreamAppMutex.Unlock()
+	fake.ScaleStreamAppStub = nil
+	fake.scaleStreamAppReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ScaleStreamAppReturnsOnCall(i int, result1 error) {
+	fake.scaleStreamAppMutex.Lock()
+	defer fake.scaleStreamAppMutex.Unlock()
+	fake.ScaleStreamAppStub = nil
+	if fake.scaleStreamAppReturnsOnCall == nil {
+		fake.scaleStreamAppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scaleStreamAppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Schedules(arg1 context.Context, arg2 string) ([]dataflow.Schedule, error) {
+	fake.schedulesMutex.Lock()
+	ret, specificReturn := fake.schedulesReturnsOnCall[len(fake.schedulesArgsForCall)]
+	fake.schedulesArgsForCall = append(fake.schedulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SchedulesStub
+	fakeReturns := fake.schedulesReturns
+	fake.recordInvocation("Schedules", []interface{}{arg1, arg2})
+	fake.schedulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SchedulesCallCount() int {
+	fake.schedulesMutex.RLock()
+	defer fake.schedulesMutex.RUnlock()
+	return len(fake.schedulesArgsForCall)
+}
+
+func (fake *FakeClient) SchedulesCalls(stub func(context.Context, string) ([]dataflow.Schedule, error)) {
+	fake.schedulesMutex.Lock()
+	defer fake.schedulesMutex.Unlock()
+	fake.SchedulesStub = stub
+}
+
+func (fake *FakeClient) SchedulesArgsForCall(i int) (context.Context, string) {
+	fake.schedulesMutex.RLock()
+	defer fake.schedulesMutex.RUnlock()
+	argsForCall := fake.schedulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SchedulesReturns(result1 []dataflow.Schedule, result2 error) {
+	fake.schedulesMutex.Lock()
+	defer fake.schedulesMutex.Unlock()
+	fake.SchedulesStub = nil
+	fake.schedulesReturns = struct {
+		result1 []dataflow.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SchedulesReturnsOnCall(i int, result1 []dataflow.Schedule, result2 error) {
+	fake.schedulesMutex.Lock()
+	defer fake.schedulesMutex.Unlock()
+	fake.SchedulesStub = nil
+	if fake.schedulesReturnsOnCall == nil {
+		fake.schedulesReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.Schedule
+			result2 error
+		})
+	}
+	fake.schedulesReturnsOnCall[i] = struct {
+		result1 []dataflow.Schedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SecurityInfo(arg1 context.Context) (*dataflow.SecurityInfo, error) {
+	fake.securityInfoMutex.Lock()
+	ret, specificReturn := fake.securityInfoReturnsOnCall[len(fake.securityInfoArgsForCall)]
+	fake.securityInfoArgsForCall = append(fake.securityInfoArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.SecurityInfoStub
+	fakeReturns := fake.securityInfoReturns
+	fake.recordInvocation("SecurityInfo", []interface{}{arg1})
+	fake.securityInfoMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SecurityInfoCallCount() int {
+	fake.securityInfoMutex.RLock()
+	defer fake.securityInfoMutex.RUnlock()
+	return len(fake.securityInfoArgsForCall)
+}
+
+func (fake *FakeClient) SecurityInfoCalls(stub func(context.Context) (*dataflow.SecurityInfo, error)) {
+	fake.securityInfoMutex.Lock()
+	defer fake.securityInfoMutex.Unlock()
+	fake.SecurityInfoStub = stub
+}
+
+func (fake *FakeClient) SecurityInfoArgsForCall(i int) context.Context {
+	fake.securityInfoMutex.RLock()
+	defer fake.securityInfoMutex.RUnlock()
+	argsForCall := fake.securityInfoArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) SecurityInfoReturns(result1 *dataflow.SecurityInfo, result2 error) {
+	fake.securityInfoMutex.Lock()
+	defer fake.securityInfoMutex.Unlock()
+	fake.SecurityInfoStub = nil
+	fake.securityInfoReturns = struct {
+		result1 *dataflow.SecurityInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SecurityInfoReturnsOnCall(i int, result1 *dataflow.SecurityInfo, result2 error) {
+	fake.securityInfoMutex.Lock()
+	defer fake.securityInfoMutex.Unlock()
+	fake.SecurityInfoStub = nil
+	if fake.securityInfoReturnsOnCall == nil {
+		fake.securityInfoReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.SecurityInfo
+			result2 error
+		})
+	}
+	fake.securityInfoReturnsOnCall[i] = struct {
+		result1 *dataflow.SecurityInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ServerUrl() string {
+	fake.serverUrlMutex.Lock()
+	ret, specificReturn := fake.serverUrlReturnsOnCall[len(fake.serverUrlArgsForCall)]
+	fake.serverUrlArgsForCall = append(fake.serverUrlArgsForCall, struct {
+	}{})
+	stub := fake.ServerUrlStub
+	fakeReturns := fake.serverUrlReturns
+	fake.recordInvocation("ServerUrl", []interface{}{})
+	fake.serverUrlMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ServerUrlCallCount() int {
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	return len(fake.serverUrlArgsForCall)
+}
+
+func (fake *FakeClient) ServerUrlCalls(stub func() string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = stub
+}
+
+func (fake *FakeClient) ServerUrlReturns(result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	fake.serverUrlReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) ServerUrlReturnsOnCall(i int, result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	if fake.serverUrlReturnsOnCall == nil {
+		fake.serverUrlReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.serverUrlReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) StopJobExecution(arg1 context.Context, arg2 int64) error {
+	fake.stopJobExecutionMutex.Lock()
+	ret, specificReturn := fake.stopJobExecutionReturnsOnCall[len(fake.stopJobExecutionArgsForCall)]
+	fake.stopJobExecutionArgsForCall = append(fake.stopJobExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.StopJobExecutionStub
+	fakeReturns := fake.stopJobExecutionReturns
+	fake.recordInvocation("StopJobExecution", []interface{}{arg1, arg2})
+	fake.stopJobExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) StopJobExecutionCallCount() int {
+	fake.stopJobExecutionMutex.RLock()
+	defer fake.stopJobExecutionMutex.RUnlock()
+	return len(fake.stopJobExecutionArgsForCall)
+}
+
+func (fake *FakeClient) StopJobExecutionCalls(stub func(context.Context, int64) error) {
+	fake.stopJobExecutionMutex.Lock()
+	defer fake.stopJobExecutionMutex.Unlock()
+	fake.StopJobExecutionStub = stub
+}
+
+func (fake *FakeClient) StopJobExecutionArgsForCall(i int) (context.Context, int64) {
+	fake.stopJobExecutionMutex.RLock()
+	defer fake.stopJobExecutionMutex.RUnlock()
+	argsForCall := fake.stopJobExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) StopJobExecutionReturns(result1 error) {
+	fake.stopJobExecutionMutex.Lock()
+	defer fake.stopJobExecutionMutex.Unlock()
+	fake.StopJobExecutionStub = nil
+	fake.stopJobExecutionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) StopJobExecutionReturnsOnCall(i int, result1 error) {
+	fake.stopJobExecutionMutex.Lock()
+	defer fake.stopJobExecutionMutex.Unlock()
+	fake.StopJobExecutionStub = nil
+	if fake.stopJobExecutionReturnsOnCall == nil {
+		fake.stopJobExecutionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stopJobExecutionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) StreamDefinition(arg1 context.Context, arg2 string) (*dataflow.StreamDefinition, error) {
+	fake.streamDefinitionMutex.Lock()
+	ret, specificReturn := fake.streamDefinitionReturnsOnCall[len(fake.streamDefinitionArgsForCall)]
+	fake.streamDefinitionArgsForCall = append(fake.streamDefinitionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.StreamDefinitionStub
+	fakeReturns := fake.streamDefinitionReturns
+	fake.recordInvocation("StreamDefinition", []interface{}{arg1, arg2})
+	fake.streamDefinitionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamDefinitionCallCount() int {
+	fake.streamDefinitionMutex.RLock()
+	defer fake.streamDefinitionMutex.RUnlock()
+	return len(fake.streamDefinitionArgsForCall)
+}
+
+func (fake *FakeClient) StreamDefinitionCalls(stub func(context.Context, string) (*dataflow.StreamDefinition, error)) {
+	fake.streamDefinitionMutex.Lock()
+	defer fake.streamDefinitionMutex.Unlock()
+	fake.StreamDefinitionStub = stub
+}
+
+func (fake *FakeClient) StreamDefinitionArgsForCall(i int) (context.Context, string) {
+	fake.streamDefinitionMutex.RLock()
+	defer fake.streamDefinitionMutex.RUnlock()
+	argsForCall := fake.streamDefinitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) StreamDefinitionReturns(result1 *dataflow.StreamDefinition, result2 error) {
+	fake.streamDefinitionMutex.Lock()
+	defer fake.streamDefinitionMutex.Unlock()
+	fake.StreamDefinitionStub = nil
+	fake.streamDefinitionReturns = struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamDefinitionReturnsOnCall(i int, result1 *dataflow.StreamDefinition, result2 error) {
+	fake.streamDefinitionMutex.Lock()
+	defer fake.streamDefinitionMutex.Unlock()
+	fake.StreamDefinitionStub = nil
+	if fake.streamDefinitionReturnsOnCall == nil {
+		fake.streamDefinitionReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.StreamDefinition
+			result2 error
+		})
+	}
+	fake.streamDefinitionReturnsOnCall[i] = struct {
+		result1 *dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamDefinitions(arg1 context.Context) ([]dataflow.StreamDefinition, error) {
+	fake.streamDefinitionsMutex.Lock()
+	ret, specificReturn := fake.streamDefinitionsReturnsOnCall[len(fake.streamDefinitionsArgsForCall)]
+	fake.streamDefinitionsArgsForCall = append(fake.streamDefinitionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.StreamDefinitionsStub
+	fakeReturns := fake.streamDefinitionsReturns
+	fake.recordInvocation("StreamDefinitions", []interface{}{arg1})
+	fake.streamDefinitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamDefinitionsCallCount() int {
+	fake.streamDefinitionsMutex.RLock()
+	defer fake.streamDefinitionsMutex.RUnlock()
+	return len(fake.streamDefinitionsArgsForCall)
+}
+
+func (fake *FakeClient) StreamDefinitionsCalls(stub func(context.Context) ([]dataflow.StreamDefinition, error)) {
+	fake.streamDefinitionsMutex.Lock()
+	defer fake.streamDefinitionsMutex.Unlock()
+	fake.StreamDefinitionsStub = stub
+}
+
+func (fake *FakeClient) StreamDefinitionsArgsForCall(i int) context.Context {
+	fake.streamDefinitionsMutex.RLock()
+	defer fake.streamDefinitionsMutex.RUnlock()
+	argsForCall := fake.streamDefinitionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) StreamDefinitionsReturns(result1 []dataflow.StreamDefinition, result2 error) {
+	fake.streamDefinitionsMutex.Lock()
+	defer fake.streamDefinitionsMutex.Unlock()
+	fake.StreamDefinitionsStub = nil
+	fake.streamDefinitionsReturns = struct {
+		result1 []dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamDefinitionsReturnsOnCall(i int, result1 []dataflow.StreamDefinition, result2 error) {
+	fake.streamDefinitionsMutex.Lock()
+	defer fake.streamDefinitionsMutex.Unlock()
+	fake.StreamDefinitionsStub = nil
+	if fake.streamDefinitionsReturnsOnCall == nil {
+		fake.streamDefinitionsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.StreamDefinition
+			result2 error
+		})
+	}
+	fake.streamDefinitionsReturnsOnCall[i] = struct {
+		result1 []dataflow.StreamDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamDeployment(arg1 context.Context, arg2 string) (*dataflow.StreamDeployment, error) {
+	fake.streamDeploymentMutex.Lock()
+	ret, specificReturn := fake.streamDeploymentReturnsOnCall[len(fake.streamDeploymentArgsForCall)]
+	fake.streamDeploymentArgsForCall = append(fake.streamDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.StreamDeploymentStub
+	fakeReturns := fake.streamDeploymentReturns
+	fake.recordInvocation("StreamDeployment", []interface{}{arg1, arg2})
+	fake.streamDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamDeploymentCallCount() int {
+	fake.streamDeploymentMutex.RLock()
+	defer fake.streamDeploymentMutex.RUnlock()
+	return len(fake.streamDeploymentArgsForCall)
+}
+
+func (fake *FakeClient) StreamDeploymentCalls(stub func(context.Context, string) (*dataflow.StreamDeployment, error)) {
+	fake.streamDeploymentMutex.Lock()
+	defer fake.streamDeploymentMutex.Unlock()
+	fake.StreamDeploymentStub = stub
+}
+
+func (fake *FakeClient) StreamDeploymentArgsForCall(i int) (context.Context, string) {
+	fake.streamDeploymentMutex.RLock()
+	defer fake.streamDeploymentMutex.RUnlock()
+	argsForCall := fake.streamDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) StreamDeploymentReturns(result1 *dataflow.StreamDeployment, result2 error) {
+	fake.streamDeploymentMutex.Lock()
+	defer fake.streamDeploymentMutex.Unlock()
+	fake.StreamDeploymentStub = nil
+	fake.streamDeploymentReturns = struct {
+		result1 *dataflow.StreamDeployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamDeploymentReturnsOnCall(i int, result1 *dataflow.StreamDeployment, result2 error) {
+	fake.streamDeploymentMutex.Lock()
+	defer fake.streamDeploymentMutex.Unlock()
+	fake.StreamDeploymentStub = nil
+	if fake.streamDeploymentReturnsOnCall == nil {
+		fake.streamDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.StreamDeployment
+			result2 error
+		})
+	}
+	fake.streamDeploymentReturnsOnCall[i] = struct {
+		result1 *dataflow.StreamDeployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamHistory(arg1 context.Context, arg2 string) ([]dataflow.StreamRelease, error) {
+	fake.streamHistoryMutex.Lock()
+	ret, specificReturn := fake.streamHistoryReturnsOnCall[len(fake.streamHistoryArgsForCall)]
+	fake.streamHistoryArgsForCall = append(fake.streamHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.StreamHistoryStub
+	fakeReturns := fake.streamHistoryReturns
+	fake.recordInvocation("StreamHistory", []interface{}{arg1, arg2})
+	fake.streamHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamHistoryCallCount() int {
+	fake.streamHistoryMutex.RLock()
+	defer fake.streamHistoryMutex.RUnlock()
+	return len(fake.streamHistoryArgsForCall)
+}
+
+func (fake *FakeClient) StreamHistoryCalls(stub func(context.Context, string) ([]dataflow.StreamRelease, error)) {
+	fake.streamHistoryMutex.Lock()
+	defer fake.streamHistoryMutex.Unlock()
+	fake.StreamHistoryStub = stub
+}
+
+func (fake *FakeClient) StreamHistoryArgsForCall(i int) (context.Context, string) {
+	fake.streamHistoryMutex.RLock()
+	defer fake.streamHistoryMutex.RUnlock()
+	argsForCall := fake.streamHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) StreamHistoryReturns(result1 []dataflow.StreamRelease, result2 error) {
+	fake.streamHistoryMutex.Lock()
+	defer fake.streamHistoryMutex.Unlock()
+	fake.StreamHistoryStub = nil
+	fake.streamHistoryReturns = struct {
+		result1 []dataflow.StreamRelease
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamHistoryReturnsOnCall(i int, result1 []dataflow.StreamRelease, result2 error) {
+	fake.streamHistoryMutex.Lock()
+	defer fake.streamHistoryMutex.Unlock()
+	fake.StreamHistoryStub = nil
+	if fake.streamHistoryReturnsOnCall == nil {
+		fake.streamHistoryReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.StreamRelease
+			result2 error
+		})
+	}
+	fake.streamHistoryReturnsOnCall[i] = struct {
+		result1 []dataflow.StreamRelease
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamManifest(arg1 context.Context, arg2 string, arg3 int) (string, error) {
+	fake.streamManifestMutex.Lock()
+	ret, specificReturn := fake.streamManifestReturnsOnCall[len(fake.streamManifestArgsForCall)]
+	fake.streamManifestArgsForCall = append(fake.streamManifestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.StreamManifestStub
+	fakeReturns := fake.streamManifestReturns
+	fake.recordInvocation("StreamManifest", []interface{}{arg1, arg2, arg3})
+	fake.streamManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamManifestCallCount() int {
+	fake.streamManifestMutex.RLock()
+	defer fake.streamManifestMutex.RUnlock()
+	return len(fake.streamManifestArgsForCall)
+}
+
+func (fake *FakeClient) StreamManifestCalls(stub func(context.Context, string, int) (string, error)) {
+	fake.streamManifestMutex.Lock()
+	defer fake.streamManifestMutex.Unlock()
+	fake.StreamManifestStub = stub
+}
+
+func (fake *FakeClient) StreamManifestArgsForCall(i int) (context.Context, string, int) {
+	fake.streamManifestMutex.RLock()
+	defer fake.streamManifestMutex.RUnlock()
+	argsForCall := fake.streamManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) StreamManifestReturns(result1 string, result2 error) {
+	fake.streamManifestMutex.Lock()
+	defer fake.streamManifestMutex.Unlock()
+	fake.StreamManifestStub = nil
+	fake.streamManifestReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamManifestReturnsOnCall(i int, result1 string, result2 error) {
+	fake.streamManifestMutex.Lock()
+	defer fake.streamManifestMutex.Unlock()
+	fake.StreamManifestStub = nil
+	if fake.streamManifestReturnsOnCall == nil {
+		fake.streamManifestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.streamManifestReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamPlatforms(arg1 context.Context) ([]dataflow.Platform, error) {
+	fake.streamPlatformsMutex.Lock()
+	ret, specificReturn := fake.streamPlatformsReturnsOnCall[len(fake.streamPlatformsArgsForCall)]
+	fake.streamPlatformsArgsForCall = append(fake.streamPlatformsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.StreamPlatformsStub
+	fakeReturns := fake.streamPlatformsReturns
+	fake.recordInvocation("StreamPlatforms", []interface{}{arg1})
+	fake.streamPlatformsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamPlatformsCallCount() int {
+	fake.streamPlatformsMutex.RLock()
+	defer fake.streamPlatformsMutex.RUnlock()
+	return len(fake.streamPlatformsArgsForCall)
+}
+
+func (fake *FakeClient) StreamPlatformsCalls(stub func(context.Context) ([]dataflow.Platform, error)) {
+	fake.streamPlatformsMutex.Lock()
+	defer fake.streamPlatformsMutex.Unlock()
+	fake.StreamPlatformsStub = stub
+}
+
+func (fake *FakeClient) StreamPlatformsArgsForCall(i int) context.Context {
+	fake.streamPlatformsMutex.RLock()
+	defer fake.streamPlatformsMutex.RUnlock()
+	argsForCall := fake.streamPlatformsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) StreamPlatformsReturns(result1 []dataflow.Platform, result2 error) {
+	fake.streamPlatformsMutex.Lock()
+	defer fake.streamPlatformsMutex.Unlock()
+	fake.StreamPlatformsStub = nil
+	fake.streamPlatformsReturns = struct {
+		result1 []dataflow.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamPlatformsReturnsOnCall(i int, result1 []dataflow.Platform, result2 error) {
+	fake.streamPlatformsMutex.Lock()
+	defer fake.streamPlatformsMutex.Unlock()
+	fake.StreamPlatformsStub = nil
+	if fake.streamPlatformsReturnsOnCall == nil {
+		fake.streamPlatformsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.Platform
+			result2 error
+		})
+	}
+	fake.streamPlatformsReturnsOnCall[i] = struct {
+		result1 []dataflow.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamRuntimeStatus(arg1 context.Context, arg2 ...string) ([]dataflow.StreamStatus, error) {
+	fake.streamRuntimeStatusMutex.Lock()
+	ret, specificReturn := fake.streamRuntimeStatusReturnsOnCall[len(fake.streamRuntimeStatusArgsForCall)]
+	fake.streamRuntimeStatusArgsForCall = append(fake.streamRuntimeStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2})
+	stub := fake.StreamRuntimeStatusStub
+	fakeReturns := fake.streamRuntimeStatusReturns
+	fake.recordInvocation("StreamRuntimeStatus", []interface{}{arg1, arg2})
+	fake.streamRuntimeStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamRuntimeStatusCallCount() int {
+	fake.streamRuntimeStatusMutex.RLock()
+	defer fake.streamRuntimeStatusMutex.RUnlock()
+	return len(fake.streamRuntimeStatusArgsForCall)
+}
+
+func (fake *FakeClient) StreamRuntimeStatusCalls(stub func(context.Context, ...string) ([]dataflow.StreamStatus, error)) {
+	fake.streamRuntimeStatusMutex.Lock()
+	defer fake.streamRuntimeStatusMutex.Unlock()
+	fake.StreamRuntimeStatusStub = stub
+}
+
+func (fake *FakeClient) StreamRuntimeStatusArgsForCall(i int) (context.Context, []string) {
+	fake.streamRuntimeStatusMutex.RLock()
+	defer fake.streamRuntimeStatusMutex.RUnlock()
+	argsForCall := fake.streamRuntimeStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) StreamRuntimeStatusReturns(result1 []dataflow.StreamStatus, result2 error) {
+	fake.streamRuntimeStatusMutex.Lock()
+	defer fake.streamRuntimeStatusMutex.Unlock()
+	fake.StreamRuntimeStatusStub = nil
+	fake.streamRuntimeStatusReturns = struct {
+		result1 []dataflow.StreamStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamRuntimeStatusReturnsOnCall(i int, result1 []dataflow.StreamStatus, result2 error) {
+	fake.streamRuntimeStatusMutex.Lock()
+	defer fake.streamRuntimeStatusMutex.Unlock()
+	fake.StreamRuntimeStatusStub = nil
+	if fake.streamRuntimeStatusReturnsOnCall == nil {
+		fake.streamRuntimeStatusReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.StreamStatus
+			result2 error
+		})
+	}
+	fake.streamRuntimeStatusReturnsOnCall[i] = struct {
+		result1 []dataflow.StreamStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskDefinition(arg1 context.Context, arg2 string) (*dataflow.TaskDefinition, error) {
+	fake.taskDefinitionMutex.Lock()
+	ret, specificReturn := fake.taskDefinitionReturnsOnCall[len(fake.taskDefinitionArgsForCall)]
+	fake.taskDefinitionArgsForCall = append(fake.taskDefinitionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskDefinitionStub
+	fakeReturns := fake.taskDefinitionReturns
+	fake.recordInvocation("TaskDefinition", []interface{}{arg1, arg2})
+	fake.taskDefinitionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskDefinitionCallCount() int {
+	fake.taskDefinitionMutex.RLock()
+	defer fake.taskDefinitionMutex.RUnlock()
+	return len(fake.taskDefinitionArgsForCall)
+}
+
+func (fake *FakeClient) TaskDefinitionCalls(stub func(context.Context, string) (*dataflow.TaskDefinition, error)) {
+	fake.taskDefinitionMutex.Lock()
+	defer fake.taskDefinitionMutex.Unlock()
+	fake.TaskDefinitionStub = stub
+}
+
+func (fake *FakeClient) TaskDefinitionArgsForCall(i int) (context.Context, string) {
+	fake.taskDefinitionMutex.RLock()
+	defer fake.taskDefinitionMutex.RUnlock()
+	argsForCall := fake.taskDefinitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) TaskDefinitionReturns(result1 *dataflow.TaskDefinition, result2 error) {
+	fake.taskDefinitionMutex.Lock()
+	defer fake.taskDefinitionMutex.Unlock()
+	fake.TaskDefinitionStub = nil
+	fake.taskDefinitionReturns = struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskDefinitionReturnsOnCall(i int, result1 *dataflow.TaskDefinition, result2 error) {
+	fake.taskDefinitionMutex.Lock()
+	defer fake.taskDefinitionMutex.Unlock()
+	fake.TaskDefinitionStub = nil
+	if fake.taskDefinitionReturnsOnCall == nil {
+		fake.taskDefinitionReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.TaskDefinition
+			result2 error
+		})
+	}
+	fake.taskDefinitionReturnsOnCall[i] = struct {
+		result1 *dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskDefinitions(arg1 context.Context) ([]dataflow.TaskDefinition, error) {
+	fake.taskDefinitionsMutex.Lock()
+	ret, specificReturn := fake.taskDefinitionsReturnsOnCall[len(fake.taskDefinitionsArgsForCall)]
+	fake.taskDefinitionsArgsForCall = append(fake.taskDefinitionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TaskDefinitionsStub
+	fakeReturns := fake.taskDefinitionsReturns
+	fake.recordInvocation("TaskDefinitions", []interface{}{arg1})
+	fake.taskDefinitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskDefinitionsCallCount() int {
+	fake.taskDefinitionsMutex.RLock()
+	defer fake.taskDefinitionsMutex.RUnlock()
+	return len(fake.taskDefinitionsArgsForCall)
+}
+
+func (fake *FakeClient) TaskDefinitionsCalls(stub func(context.Context) ([]dataflow.TaskDefinition, error)) {
+	fake.taskDefinitionsMutex.Lock()
+	defer fake.taskDefinitionsMutex.Unlock()
+	fake.TaskDefinitionsStub = stub
+}
+
+func (fake *FakeClient) TaskDefinitionsArgsForCall(i int) context.Context {
+	fake.taskDefinitionsMutex.RLock()
+	defer fake.taskDefinitionsMutex.RUnlock()
+	argsForCall := fake.taskDefinitionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) TaskDefinitionsReturns(result1 []dataflow.TaskDefinition, result2 error) {
+	fake.taskDefinitionsMutex.Lock()
+	defer fake.taskDefinitionsMutex.Unlock()
+	fake.TaskDefinitionsStub = nil
+	fake.taskDefinitionsReturns = struct {
+		result1 []dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskDefinitionsReturnsOnCall(i int, result1 []dataflow.TaskDefinition, result2 error) {
+	fake.taskDefinitionsMutex.Lock()
+	defer fake.taskDefinitionsMutex.Unlock()
+	fake.TaskDefinitionsStub = nil
+	if fake.taskDefinitionsReturnsOnCall == nil {
+		fake.taskDefinitionsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.TaskDefinition
+			result2 error
+		})
+	}
+	fake.taskDefinitionsReturnsOnCall[i] = struct {
+		result1 []dataflow.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskExecution(arg1 context.Context, arg2 int64) (*dataflow.TaskExecution, error) {
+	fake.taskExecutionMutex.Lock()
+	ret, specificReturn := fake.taskExecutionReturnsOnCall[len(fake.taskExecutionArgsForCall)]
+	fake.taskExecutionArgsForCall = append(fake.taskExecutionArgsForCall, struct {
+		arg1 context.Context
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.TaskExecutionStub
+	fakeReturns := fake.taskExecutionReturns
+	fake.recordInvocation("TaskExecution", []interface{}{arg1, arg2})
+	fake.taskExecutionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskExecutionCallCount() int {
+	fake.taskExecutionMutex.RLock()
+	defer fake.taskExecutionMutex.RUnlock()
+	return len(fake.taskExecutionArgsForCall)
+}
+
+func (fake *FakeClient) TaskExecutionCalls(stub func(context.Context, int64) (*dataflow.TaskExecution, error)) {
+	fake.taskExecutionMutex.Lock()
+	defer fake.taskExecutionMutex.Unlock()
+	fake.TaskExecutionStub = stub
+}
+
+func (fake *FakeClient) TaskExecutionArgsForCall(i int) (context.Context, int64) {
+	fake.taskExecutionMutex.RLock()
+	defer fake.taskExecutionMutex.RUnlock()
+	argsForCall := fake.taskExecutionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) TaskExecutionReturns(result1 *dataflow.TaskExecution, result2 error) {
+	fake.taskExecutionMutex.Lock()
+	defer fake.taskExecutionMutex.Unlock()
+	fake.TaskExecutionStub = nil
+	fake.taskExecutionReturns = struct {
+		result1 *dataflow.TaskExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskExecutionReturnsOnCall(i int, result1 *dataflow.TaskExecution, result2 error) {
+	fake.taskExecutionMutex.Lock()
+	defer fake.taskExecutionMutex.Unlock()
+	fake.TaskExecutionStub = nil
+	if fake.taskExecutionReturnsOnCall == nil {
+		fake.taskExecutionReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.TaskExecution
+			result2 error
+		})
+	}
+	fake.taskExecutionReturnsOnCall[i] = struct {
+		result1 *dataflow.TaskExecution
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskExecutionPage(arg1 context.Context, arg2 string, arg3 int, arg4 int) (*dataflow.TaskExecutionPage, error) {
+	fake.taskExecutionPageMutex.Lock()
+	ret, specificReturn := fake.taskExecutionPageReturnsOnCall[len(fake.taskExecutionPageArgsForCall)]
+	fake.taskExecutionPageArgsForCall = append(fake.taskExecutionPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TaskExecutionPageStub
+	fakeReturns := fake.taskExecutionPageReturns
+	fake.recordInvocation("TaskExecutionPage", []interface{}{arg1, arg2, arg3, arg4})
+	fake.taskExecutionPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskExecutionPageCallCount() int {
+	fake.taskExecutionPageMutex.RLock()
+	defer fake.taskExecutionPageMutex.RUnlock()
+	return len(fake.taskExecutionPageArgsForCall)
+}
+
+func (fake *FakeClient) TaskExecutionPageCalls(stub func(context.Context, string, int, int) (*dataflow.TaskExecutionPage, error)) {
+	fake.taskExecutionPageMutex.Lock()
+	defer fake.taskExecutionPageMutex.Unlock()
+	fake.TaskExecutionPageStub = stub
+}
+
+func (fake *FakeClient) TaskExecutionPageArgsForCall(i int) (context.Context, string, int, int) {
+	fake.taskExecutionPageMutex.RLock()
+	defer fake.taskExecutionPageMutex.RUnlock()
+	argsForCall := fake.taskExecutionPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) TaskExecutionPageReturns(result1 *dataflow.TaskExecutionPage, result2 error) {
+	fake.taskExecutionPageMutex.Lock()
+	defer fake.taskExecutionPageMutex.Unlock()
+	fake.TaskExecutionPageStub = nil
+	fake.taskExecutionPageReturns = struct {
+		result1 *dataflow.TaskExecutionPage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskExecutionPageReturnsOnCall(i int, result1 *dataflow.TaskExecutionPage, result2 error) {
+	fake.taskExecutionPageMutex.Lock()
+	defer fake.taskExecutionPageMutex.Unlock()
+	fake.TaskExecutionPageStub = nil
+	if fake.taskExecutionPageReturnsOnCall == nil {
+		fake.taskExecutionPageReturnsOnCall = make(map[int]struct {
+			result1 *dataflow.TaskExecutionPage
+			result2 error
+		})
+	}
+	fake.taskExecutionPageReturnsOnCall[i] = struct {
+		result1 *dataflow.TaskExecutionPage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskExecutions(arg1 context.Context, arg2 string, arg3 int) hal.Iterator {
+	fake.taskExecutionsMutex.Lock()
+	ret, specificReturn := fake.taskExecutionsReturnsOnCall[len(fake.taskExecutionsArgsForCall)]
+	fake.taskExecutionsArgsForCall = append(fake.taskExecutionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.TaskExecutionsStub
+	fakeReturns := fake.taskExecutionsReturns
+	fake.recordInvocation("TaskExecutions", []interface{}{arg1, arg2, arg3})
+	fake.taskExecutionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) TaskExecutionsCallCount() int {
+	fake.taskExecutionsMutex.RLock()
+	defer fake.taskExecutionsMutex.RUnlock()
+	return len(fake.taskExecutionsArgsForCall)
+}
+
+func (fake *FakeClient) TaskExecutionsCalls(stub func(context.Context, string, int) hal.Iterator) {
+	fake.taskExecutionsMutex.Lock()
+	defer fake.taskExecutionsMutex.Unlock()
+	fake.TaskExecutionsStub = stub
+}
+
+func (fake *FakeClient) TaskExecutionsArgsForCall(i int) (context.Context, string, int) {
+	fake.taskExecutionsMutex.RLock()
+	defer fake.taskExecutionsMutex.RUnlock()
+	argsForCall := fake.taskExecutionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TaskExecutionsReturns(result1 hal.Iterator) {
+	fake.taskExecutionsMutex.Lock()
+	defer fake.taskExecutionsMutex.Unlock()
+	fake.TaskExecutionsStub = nil
+	fake.taskExecutionsReturns = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) TaskExecutionsReturnsOnCall(i int, result1 hal.Iterator) {
+	fake.taskExecutionsMutex.Lock()
+	defer fake.taskExecutionsMutex.Unlock()
+	fake.TaskExecutionsStub = nil
+	if fake.taskExecutionsReturnsOnCall == nil {
+		fake.taskExecutionsReturnsOnCall = make(map[int]struct {
+			result1 hal.Iterator
+		})
+	}
+	fake.taskExecutionsReturnsOnCall[i] = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) TaskLog(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+	fake.taskLogMutex.Lock()
+	ret, specificReturn := fake.taskLogReturnsOnCall[len(fake.taskLogArgsForCall)]
+	fake.taskLogArgsForCall = append(fake.taskLogArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskLogStub
+	fakeReturns := fake.taskLogReturns
+	fake.recordInvocation("TaskLog", []interface{}{arg1, arg2, arg3})
+	fake.taskLogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskLogCallCount() int {
+	fake.taskLogMutex.RLock()
+	defer fake.taskLogMutex.RUnlock()
+	return len(fake.taskLogArgsForCall)
+}
+
+func (fake *FakeClient) TaskLogCalls(stub func(context.Context, string, string) (string, error)) {
+	fake.taskLogMutex.Lock()
+	defer fake.taskLogMutex.Unlock()
+	fake.TaskLogStub = stub
+}
+
+func (fake *FakeClient) TaskLogArgsForCall(i int) (context.Context, string, string) {
+	fake.taskLogMutex.RLock()
+	defer fake.taskLogMutex.RUnlock()
+	argsForCall := fake.taskLogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TaskLogReturns(result1 string, result2 error) {
+	fake.taskLogMutex.Lock()
+	defer fake.taskLogMutex.Unlock()
+	fake.TaskLogStub = nil
+	fake.taskLogReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskLogReturnsOnCall(i int, result1 string, result2 error) {
+	fake.taskLogMutex.Lock()
+	defer fake.taskLogMutex.Unlock()
+	fake.TaskLogStub = nil
+	if fake.taskLogReturnsOnCall == nil {
+		fake.taskLogReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.taskLogReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskPlatforms(arg1 context.Context) ([]dataflow.Platform, error) {
+	fake.taskPlatformsMutex.Lock()
+	ret, specificReturn := fake.taskPlatformsReturnsOnCall[len(fake.taskPlatformsArgsForCall)]
+	fake.taskPlatformsArgsForCall = append(fake.taskPlatformsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TaskPlatformsStub
+	fakeReturns := fake.taskPlatformsReturns
+	fake.recordInvocation("TaskPlatforms", []interface{}{arg1})
+	fake.taskPlatformsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskPlatformsCallCount() int {
+	fake.taskPlatformsMutex.RLock()
+	defer fake.taskPlatformsMutex.RUnlock()
+	return len(fake.taskPlatformsArgsForCall)
+}
+
+func (fake *FakeClient) TaskPlatformsCalls(stub func(context.Context) ([]dataflow.Platform, error)) {
+	fake.taskPlatformsMutex.Lock()
+	defer fake.taskPlatformsMutex.Unlock()
+	fake.TaskPlatformsStub = stub
+}
+
+func (fake *FakeClient) TaskPlatformsArgsForCall(i int) context.Context {
+	fake.taskPlatformsMutex.RLock()
+	defer fake.taskPlatformsMutex.RUnlock()
+	argsForCall := fake.taskPlatformsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) TaskPlatformsReturns(result1 []dataflow.Platform, result2 error) {
+	fake.taskPlatformsMutex.Lock()
+	defer fake.taskPlatformsMutex.Unlock()
+	fake.TaskPlatformsStub = nil
+	fake.taskPlatformsReturns = struct {
+		result1 []dataflow.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskPlatformsReturnsOnCall(i int, result1 []dataflow.Platform, result2 error) {
+	fake.taskPlatformsMutex.Lock()
+	defer fake.taskPlatformsMutex.Unlock()
+	fake.TaskPlatformsStub = nil
+	if fake.taskPlatformsReturnsOnCall == nil {
+		fake.taskPlatformsReturnsOnCall = make(map[int]struct {
+			result1 []dataflow.Platform
+			result2 error
+		})
+	}
+	fake.taskPlatformsReturnsOnCall[i] = struct {
+		result1 []dataflow.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UndeployStream(arg1 context.Context, arg2 string) error {
+	fake.undeployStreamMutex.Lock()
+	ret, specificReturn := fake.undeployStreamReturnsOnCall[len(fake.undeployStreamArgsForCall)]
+	fake.undeployStreamArgsForCall = append(fake.undeployStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UndeployStreamStub
+	fakeReturns := fake.undeployStreamReturns
+	fake.recordInvocation("UndeployStream", []interface{}{arg1, arg2})
+	fake.undeployStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UndeployStreamCallCount() int {
+	fake.undeployStreamMutex.RLock()
+	defer fake.undeployStreamMutex.RUnlock()
+	return len(fake.undeployStreamArgsForCall)
+}
+
+func (fake *FakeClient) UndeployStreamCalls(stub func(context.Context, string) error) {
+	fake.undeployStreamMutex.Lock()
+	defer fake.undeployStreamMutex.Unlock()
+	fake.UndeployStreamStub = stub
+}
+
+func (fake *FakeClient) UndeployStreamArgsForCall(i int) (context.Context, string) {
+	fake.undeployStreamMutex.RLock()
+	defer fake.undeployStreamMutex.RUnlock()
+	argsForCall := fake.undeployStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) UndeployStreamReturns(result1 error) {
+	fake.undeployStreamMutex.Lock()
+	defer fake.undeployStreamMutex.Unlock()
+	fake.UndeployStreamStub = nil
+	fake.undeployStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UndeployStreamReturnsOnCall(i int, result1 error) {
+	fake.undeployStreamMutex.Lock()
+	defer fake.undeployStreamMutex.Unlock()
+	fake.UndeployStreamStub = nil
+	if fake.undeployStreamReturnsOnCall == nil {
+		fake.undeployStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.undeployStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnregisterApp(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.unregisterAppMutex.Lock()
+	ret, specificReturn := fake.unregisterAppReturnsOnCall[len(fake.unregisterAppArgsForCall)]
+	fake.unregisterAppArgsForCall = append(fake.unregisterAppArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UnregisterAppStub
+	fakeReturns := fake.unregisterAppReturns
+	fake.recordInvocation("UnregisterApp", []interface{}{arg1, arg2, arg3})
+	fake.unregisterAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UnregisterAppCallCount() int {
+	fake.unregisterAppMutex.RLock()
+	defer fake.unregisterAppMutex.RUnlock()
+	return len(fake.unregisterAppArgsForCall)
+}
+
+func (fake *FakeClient) UnregisterAppCalls(stub func(context.Context, string, string) error) {
+	fake.unregisterAppMutex.Lock()
+	defer fake.unregisterAppMutex.Unlock()
+	fake.UnregisterAppStub = stub
+}
+
+func (fake *FakeClient) UnregisterAppArgsForCall(i int) (context.Context, string, string) {
+	fake.unregisterAppMutex.RLock()
+	defer fake.unregisterAppMutex.RUnlock()
+	argsForCall := fake.unregisterAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UnregisterAppReturns(result1 error) {
+	fake.unregisterAppMutex.Lock()
+	defer fake.unregisterAppMutex.Unlock()
+	fake.UnregisterAppStub = nil
+	fake.unregisterAppReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnregisterAppReturnsOnCall(i int, result1 error) {
+	fake.unregisterAppMutex.Lock()
+	defer fake.unregisterAppMutex.Unlock()
+	fake.UnregisterAppStub = nil
+	if fake.unregisterAppReturnsOnCall == nil {
+		fake.unregisterAppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unregisterAppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateStream(arg1 context.Context, arg2 string, arg3 map[string]string) error {
+	fake.updateStreamMutex.Lock()
+	ret, specificReturn := fake.updateStreamReturnsOnCall[len(fake.updateStreamArgsForCall)]
+	fake.updateStreamArgsForCall = append(fake.updateStreamArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStreamStub
+	fakeReturns := fake.updateStreamReturns
+	fake.recordInvocation("UpdateStream", []interface{}{arg1, arg2, arg3})
+	fake.updateStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UpdateStreamCallCount() int {
+	fake.updateStreamMutex.RLock()
+	defer fake.updateStreamMutex.RUnlock()
+	return len(fake.updateStreamArgsForCall)
+}
+
+func (fake *FakeClient) UpdateStreamCalls(stub func(context.Context, string, map[string]string) error) {
+	fake.updateStreamMutex.Lock()
+	defer fake.updateStreamMutex.Unlock()
+	fake.UpdateStreamStub = stub
+}
+
+func (fake *FakeClient) UpdateStreamArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.updateStreamMutex.RLock()
+	defer fake.updateStreamMutex.RUnlock()
+	argsForCall := fake.updateStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpdateStreamReturns(result1 error) {
+	fake.updateStreamMutex.Lock()
+	defer fake.updateStreamMutex.Unlock()
+	fake.UpdateStreamStub = nil
+	fake.updateStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateStreamReturnsOnCall(i int, result1 error) {
+	fake.updateStreamMutex.Lock()
+	defer fake.updateStreamMutex.Unlock()
+	fake.UpdateStreamStub = nil
+	if fake.updateStreamReturnsOnCall == nil {
+		fake.updateStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	fake.appMutex.RLock()
+	defer fake.appMutex.RUnlock()
+	fake.appsMutex.RLock()
+	defer fake.appsMutex.RUnlock()
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	fake.cleanupTaskExecutionMutex.RLock()
+	defer fake.cleanupTaskExecutionMutex.RUnlock()
+	fake.createScheduleMutex.RLock()
+	defer fake.createScheduleMutex.RUnlock()
+	fake.createStreamMutex.RLock()
+	defer fake.createStreamMutex.RUnlock()
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	fake.deleteScheduleMutex.RLock()
+	defer fake.deleteScheduleMutex.RUnlock()
+	fake.deployStreamMutex.RLock()
+	defer fake.deployStreamMutex.RUnlock()
+	fake.destroyStreamMutex.RLock()
+	defer fake.destroyStreamMutex.RUnlock()
+	fake.destroyTaskMutex.RLock()
+	defer fake.destroyTaskMutex.RUnlock()
+	fake.jobExecutionMutex.RLock()
+	defer fake.jobExecutionMutex.RUnlock()
+	fake.jobExecutionsMutex.RLock()
+	defer fake.jobExecutionsMutex.RUnlock()
+	fake.launchTaskMutex.RLock()
+	defer fake.launchTaskMutex.RUnlock()
+	fake.registerAppMutex.RLock()
+	defer fake.registerAppMutex.RUnlock()
+	fake.restartJobExecutionMutex.RLock()
+	defer fake.restartJobExecutionMutex.RUnlock()
+	fake.rollbackStreamMutex.RLock()
+	defer fake.rollbackStreamMutex.RUnlock()
+	fake.runtimeAppMutex.RLock()
+	defer fake.runtimeAppMutex.RUnlock()
+	fake.runtimeAppsMutex.RLock()
+	defer fake.runtimeAppsMutex.RUnlock()
+	fake.scaleStreamAppMutex.RLock()
+	defer fake.scaleStreamAppMutex.RUnlock()
+	fake.schedulesMutex.RLock()
+	defer fake.schedulesMutex.RUnlock()
+	fake.securityInfoMutex.RLock()
+	defer fake.securityInfoMutex.RUnlock()
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	fake.stopJobExecutionMutex.RLock()
+	defer fake.stopJobExecutionMutex.RUnlock()
+	fake.streamDefinitionMutex.RLock()
+	defer fake.streamDefinitionMutex.RUnlock()
+	fake.streamDefinitionsMutex.RLock()
+	defer fake.streamDefinitionsMutex.RUnlock()
+	fake.streamDeploymentMutex.RLock()
+	defer fake.streamDeploymentMutex.RUnlock()
+	fake.streamHistoryMutex.RLock()
+	defer fake.streamHistoryMutex.RUnlock()
+	fake.streamManifestMutex.RLock()
+	defer fake.streamManifestMutex.RUnlock()
+	fake.streamPlatformsMutex.RLock()
+	defer fake.streamPlatformsMutex.RUnlock()
+	fake.streamRuntimeStatusMutex.RLock()
+	defer fake.streamRuntimeStatusMutex.RUnlock()
+	fake.taskDefinitionMutex.RLock()
+	defer fake.taskDefinitionMutex.RUnlock()
+	fake.taskDefinitionsMutex.RLock()
+	defer fake.taskDefinitionsMutex.RUnlock()
+	fake.taskExecutionMutex.RLock()
+	defer fake.taskExecutionMutex.RUnlock()
+	fake.taskExecutionPageMutex.RLock()
+	defer fake.taskExecutionPageMutex.RUnlock()
+	fake.taskExecutionsMutex.RLock()
+	defer fake.taskExecutionsMutex.RUnlock()
+	fake.taskLogMutex.RLock()
+	defer fake.taskLogMutex.RUnlock()
+	fake.taskPlatformsMutex.RLock()
+	defer fake.taskPlatformsMutex.RUnlock()
+	fake.undeployStreamMutex.RLock()
+	defer fake.undeployStreamMutex.RUnlock()
+	fake.unregisterAppMutex.RLock()
+	defer fake.unregisterAppMutex.RUnlock()
+	fake.updateStreamMutex.RLock()
+	defer fake.updateStreamMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ dataflow.Client = new(FakeClient)
@@ -17,6 +17,7 @@
 package dataflow
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -40,22 +41,37 @@ type AboutResp struct {
 	}
 }
 
-func DataflowShellDownloadUrl(dataflowServer string, authClient httpclient.AuthenticatedClient, accessToken string) (string, string, hash.Hash, error) {
-	defaultHashFunc := sha256.New()
-	bodyReader, statusCode, _, err := authClient.DoAuthenticatedGet(dataflowServer+"/about", accessToken)
+func DataflowShellDownloadUrl(ctx context.Context, dataflowServer string, authClient httpclient.AuthenticatedClient, accessToken string) (string, string, hash.Hash, error) {
+	body, err := FetchAbout(ctx, dataflowServer, authClient, accessToken)
+	if err != nil {
+		return "", "", sha256.New(), err
+	}
+	return DataflowShellDownloadUrlFromAbout(body)
+}
+
+// FetchAbout returns the body of the response to a request for the dataflow server's /about resource.
+func FetchAbout(ctx context.Context, dataflowServer string, authClient httpclient.AuthenticatedClient, accessToken string) ([]byte, error) {
+	bodyReader, statusCode, _, err := authClient.DoAuthenticatedGetContext(ctx, dataflowServer+"/about", accessToken)
 	if err != nil {
-		return "", "", defaultHashFunc, fmt.Errorf("Dataflow server error: %s", err)
+		return nil, fmt.Errorf("Dataflow server error: %w", err)
 	}
 	if statusCode != http.StatusOK {
-		return "", "", defaultHashFunc, fmt.Errorf("Dataflow server failed: %d", statusCode)
+		return nil, fmt.Errorf("Dataflow server failed: %d", statusCode)
 	}
 	body, err := ioutil.ReadAll(bodyReader)
 	if err != nil {
-		return "", "", defaultHashFunc, fmt.Errorf("Cannot read dataflow server response body: %s", err)
+		return nil, fmt.Errorf("Cannot read dataflow server response body: %s", err)
 	}
+	return body, nil
+}
+
+// DataflowShellDownloadUrlFromAbout is like DataflowShellDownloadUrl but uses the given body of a response from the
+// dataflow server's /about resource, as returned by FetchAbout.
+func DataflowShellDownloadUrlFromAbout(body []byte) (string, string, hash.Hash, error) {
+	defaultHashFunc := sha256.New()
 
 	var aboutResp AboutResp
-	err = json.Unmarshal(body, &aboutResp)
+	err := json.Unmarshal(body, &aboutResp)
 	if err != nil {
 		return "", "", defaultHashFunc, fmt.Errorf("Invalid dataflow server response JSON: %s, response body: '%s'", err, string(body))
 	}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+type JobExecution struct {
+	hal.Resource
+	Name                string            `json:"name"`
+	ExecutionId         int64             `json:"executionId"`
+	JobId               int64             `json:"jobId"`
+	TaskExecutionId     int64             `json:"taskExecutionId"`
+	StepExecutionCount  int               `json:"stepExecutionCount"`
+	JobParametersString string            `json:"jobParametersString"`
+	StartDate           string            `json:"startDate"`
+	StartTime           string            `json:"startTime"`
+	Duration            string            `json:"duration"`
+	Restartable         bool              `json:"restartable"`
+	Abandonable         bool              `json:"abandonable"`
+	Stoppable           bool              `json:"stoppable"`
+	Defined             bool              `json:"defined"`
+	JobExecution        BatchJobExecution `json:"jobExecution"`
+}
+
+// BatchJobExecution holds the Spring Batch details of a job execution.
+type BatchJobExecution struct {
+	Status     string     `json:"status"`
+	StartTime  hal.Time   `json:"startTime"`
+	EndTime    hal.Time   `json:"endTime"`
+	ExitStatus ExitStatus `json:"exitStatus"`
+}
+
+type ExitStatus struct {
+	ExitCode        string `json:"exitCode"`
+	ExitDescription string `json:"exitDescription"`
+}
+
+func (c *client) JobExecutions(ctx context.Context, jobName string) ([]JobExecution, error) {
+	rel, params := "jobs/executions", map[string]string(nil)
+	if jobName != "" {
+		rel, params = "jobs/executions/name", map[string]string{"name": jobName}
+	}
+	executions := []JobExecution{}
+	return executions, c.api.Collect(ctx, rel, params, "", &executions)
+}
+
+func (c *client) JobExecution(ctx context.Context, id int64) (*JobExecution, error) {
+	var execution JobExecution
+	if err := c.api.Get(ctx, "jobs/executions/execution", map[string]string{"id": formatId(id)}, &execution); err != nil {
+		return nil, err
+	}
+	return &execution, nil
+}
+
+func (c *client) RestartJobExecution(ctx context.Context, id int64) error {
+	return c.updateJobExecution(ctx, id, "restart")
+}
+
+func (c *client) StopJobExecution(ctx context.Context, id int64) error {
+	return c.updateJobExecution(ctx, id, "stop")
+}
+
+func (c *client) updateJobExecution(ctx context.Context, id int64, operation string) error {
+	return c.api.Send(ctx, http.MethodPut, "jobs/executions/execution", map[string]string{"id": formatId(id)}, func(request *httpclient.Request) {
+		request.WithQuery(operation, "true")
+	}, nil)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const deployerPropertyPrefix = "spring.cloud.deployer."
+
+// documentSeparator separates the YAML documents of a manifest.
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// ManifestApp is an application in the manifest of a stream release.
+type ManifestApp struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Resource              string            `yaml:"resource"`
+		Version               string            `yaml:"version"`
+		ApplicationProperties map[string]string `yaml:"applicationProperties"`
+		DeploymentProperties  map[string]string `yaml:"deploymentProperties"`
+	} `yaml:"spec"`
+}
+
+// ParseManifest parses the applications in a stream release manifest, as returned by StreamManifest, which consists
+// of a YAML document for each application.
+func ParseManifest(manifest string) ([]ManifestApp, error) {
+	apps := []ManifestApp{}
+	for _, document := range documentSeparator.Split(manifest, -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		var app ManifestApp
+		if err := yaml.Unmarshal([]byte(document), &app); err != nil {
+			return nil, fmt.Errorf("Invalid stream manifest: %s", err)
+		}
+		if app.Metadata.Name != "" {
+			apps = append(apps, app)
+		}
+	}
+	return apps, nil
+}
+
+// ManifestProperties returns the properties of the applications in a stream release manifest keyed as deployment
+// properties are when a stream is deployed or updated: application properties as app.LABEL.KEY, deployer properties
+// as deployer.LABEL.KEY, and application versions as version.LABEL.
+func ManifestProperties(manifest string) (map[string]string, error) {
+	apps, err := ParseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]string{}
+	for _, app := range apps {
+		label := app.Metadata.Name
+		for key, value := range app.Spec.ApplicationProperties {
+			properties["app."+label+"."+key] = value
+		}
+		for key, value := range app.Spec.DeploymentProperties {
+			properties["deployer."+label+"."+strings.TrimPrefix(key, deployerPropertyPrefix)] = value
+		}
+		if app.Spec.Version != "" {
+			properties["version."+label] = app.Spec.Version
+		}
+	}
+	return properties, nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+)
+
+var _ = Describe("Manifest", func() {
+	const manifest = `---
+apiVersion: skipper.spring.io/v1
+kind: SpringCloudDeployerApplication
+metadata:
+  name: time
+spec:
+  resource: maven://org.springframework.cloud.stream.app:time-source-rabbit
+  version: 2.1.0.RELEASE
+  applicationProperties:
+    trigger.fixed-delay: 5
+  deploymentProperties:
+    spring.cloud.deployer.memory: 512m
+---
+apiVersion: skipper.spring.io/v1
+kind: SpringCloudDeployerApplication
+metadata:
+  name: log
+spec:
+  resource: maven://org.springframework.cloud.stream.app:log-sink-rabbit
+  version: 2.1.1.RELEASE
+  applicationProperties:
+    log.level: DEBUG
+  deploymentProperties:
+    spring.cloud.deployer.count: 2
+    spring.cloud.deployer.group: ticktock
+`
+
+	It("should parse the applications", func() {
+		apps, err := dataflow.ParseManifest(manifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apps).To(HaveLen(2))
+		Expect(apps[1].Kind).To(Equal("SpringCloudDeployerApplication"))
+		Expect(apps[1].Metadata.Name).To(Equal("log"))
+		Expect(apps[1].Spec.Version).To(Equal("2.1.1.RELEASE"))
+		Expect(apps[1].Spec.DeploymentProperties).To(HaveKeyWithValue("spring.cloud.deployer.count", "2"))
+	})
+
+	It("should key properties as deployment properties", func() {
+		properties, err := dataflow.ManifestProperties(manifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(properties).To(Equal(map[string]string{
+			"app.time.trigger.fixed-delay": "5",
+			"deployer.time.memory":         "512m",
+			"version.time":                 "2.1.0.RELEASE",
+			"app.log.log.level":            "DEBUG",
+			"deployer.log.count":           "2",
+			"deployer.log.group":           "ticktock",
+			"version.log":                  "2.1.1.RELEASE",
+		}))
+	})
+
+	It("should handle an empty manifest", func() {
+		properties, err := dataflow.ManifestProperties("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(properties).To(BeEmpty())
+	})
+
+	It("should reject an invalid manifest", func() {
+		_, err := dataflow.ManifestProperties("metadata: [")
+		Expect(err).To(MatchError(HavePrefix("Invalid stream manifest: ")))
+	})
+})
@@ -0,0 +1,109 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+// Application and instance states reported by the server.
+const (
+	StateDeployed   = "deployed"
+	StateDeploying  = "deploying"
+	StateUndeployed = "undeployed"
+	StatePartial    = "partial"
+	StateIncomplete = "incomplete"
+	StateFailed     = "failed"
+	StateError      = "error"
+	StateUnknown    = "unknown"
+)
+
+// StreamStatus is the runtime status of the applications of a stream.
+type StreamStatus struct {
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	Applications struct {
+		Embedded struct {
+			AppStatuses []AppStatus `json:"appStatusResourceList"`
+		} `json:"_embedded"`
+	} `json:"applications"`
+}
+
+// Apps returns the runtime status of the stream's applications.
+func (s *StreamStatus) Apps() []AppStatus {
+	return s.Applications.Embedded.AppStatuses
+}
+
+// AppStatus is the runtime status of a deployed application.
+type AppStatus struct {
+	hal.Resource
+	DeploymentId string `json:"deploymentId"`
+	Name         string `json:"name"`
+	State        string `json:"state"`
+	Instances    struct {
+		Embedded struct {
+			InstanceStatuses []AppInstanceStatus `json:"appInstanceStatusResourceList"`
+		} `json:"_embedded"`
+	} `json:"instances"`
+}
+
+// AppInstances returns the runtime status of the application's instances.
+func (a *AppStatus) AppInstances() []AppInstanceStatus {
+	return a.Instances.Embedded.InstanceStatuses
+}
+
+// Label returns the label of the application within its stream, if known, or otherwise its name.
+func (a *AppStatus) Label() string {
+	for _, instance := range a.AppInstances() {
+		if label := instance.Attributes["skipper.application.name"]; label != "" {
+			return label
+		}
+	}
+	return a.Name
+}
+
+// AppInstanceStatus is the runtime status of an instance of a deployed application.
+type AppInstanceStatus struct {
+	InstanceId string            `json:"instanceId"`
+	State      string            `json:"state"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+func (c *client) StreamRuntimeStatus(ctx context.Context, names ...string) ([]StreamStatus, error) {
+	var params map[string]string
+	if len(names) > 0 {
+		params = map[string]string{"names": strings.Join(names, ",")}
+	}
+	statuses := []StreamStatus{}
+	return statuses, c.api.Collect(ctx, "runtime/streams", params, "", &statuses)
+}
+
+func (c *client) RuntimeApps(ctx context.Context) ([]AppStatus, error) {
+	apps := []AppStatus{}
+	return apps, c.api.Collect(ctx, "runtime/apps", nil, "", &apps)
+}
+
+func (c *client) RuntimeApp(ctx context.Context, deploymentId string) (*AppStatus, error) {
+	var app AppStatus
+	if err := c.api.Get(ctx, "runtime/apps/app", map[string]string{"appId": deploymentId}, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// CronExpressionProperty is the task launch property which holds the cron expression of a schedule.
+const CronExpressionProperty = "scheduler.cron.expression"
+
+type Schedule struct {
+	hal.Resource
+	ScheduleName       string            `json:"scheduleName"`
+	TaskDefinitionName string            `json:"taskDefinitionName"`
+	ScheduleProperties map[string]string `json:"scheduleProperties"`
+}
+
+func (c *client) Schedules(ctx context.Context, taskName string) ([]Schedule, error) {
+	rel, params := "tasks/schedules", map[string]string(nil)
+	if taskName != "" {
+		rel, params = "tasks/schedules/instances", map[string]string{"taskDefinitionName": taskName}
+	}
+	schedules := []Schedule{}
+	return schedules, c.api.Collect(ctx, rel, params, "", &schedules)
+}
+
+func (c *client) CreateSchedule(ctx context.Context, scheduleName string, taskName string, launch TaskLaunch) error {
+	values := launch.form()
+	values.Set("scheduleName", scheduleName)
+	values.Set("taskDefinitionName", taskName)
+	return c.api.Send(ctx, http.MethodPost, "tasks/schedules", nil, func(request *httpclient.Request) {
+		request.WithFormBody(values)
+	}, nil)
+}
+
+func (c *client) DeleteSchedule(ctx context.Context, scheduleName string) error {
+	return c.api.Delete(ctx, "tasks/schedules/schedule", map[string]string{"scheduleName": scheduleName})
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import "strings"
+
+// AppLabels returns the labels of the applications in the stream's definition, in order. An application which is
+// not explicitly labelled, using "label: app", is labelled with its name. Destinations, such as ":orders", are not
+// applications and are omitted.
+func (d *StreamDefinition) AppLabels() []string {
+	labels := []string{}
+	for _, app := range splitStreamDsl(d.DslText) {
+		fields := strings.Fields(app)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], ":") {
+			continue
+		}
+		label := fields[0]
+		if i := strings.Index(label, ":"); i > 0 {
+			label = label[:i]
+		}
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// splitStreamDsl splits a stream definition into its applications and destinations, which are separated by "|" or
+// ">" outside quoted property values.
+func splitStreamDsl(dsl string) []string {
+	parts := []string{}
+	var quote rune
+	start := 0
+	for i, r := range dsl {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '|' || r == '>':
+			parts = append(parts, dsl[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, dsl[start:])
+}
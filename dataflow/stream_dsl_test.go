@@ -0,0 +1,50 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
+)
+
+var _ = Describe("AppLabels", func() {
+	appLabels := func(dsl string) []string {
+		return (&dataflow.StreamDefinition{DslText: dsl}).AppLabels()
+	}
+
+	It("should label applications with their names", func() {
+		Expect(appLabels("time | log")).To(Equal([]string{"time", "log"}))
+	})
+
+	It("should return explicit labels", func() {
+		Expect(appLabels("time | t1: transform --expression=payload | t2:transform | log")).To(Equal([]string{"time", "t1", "t2", "log"}))
+	})
+
+	It("should omit destinations", func() {
+		Expect(appLabels(":orders > filter | log")).To(Equal([]string{"filter", "log"}))
+		Expect(appLabels("http --port=8080 > :orders")).To(Equal([]string{"http"}))
+	})
+
+	It("should ignore separators in quoted property values", func() {
+		Expect(appLabels(`http | transform --expression='payload > 1 | 2' | log --name="a|b"`)).To(Equal([]string{"http", "transform", "log"}))
+	})
+
+	It("should return no labels for an empty definition", func() {
+		Expect(appLabels("")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,235 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+type StreamDefinition struct {
+	hal.Resource
+	Name              string `json:"name"`
+	DslText           string `json:"dslText"`
+	OriginalDslText   string `json:"originalDslText"`
+	Status            string `json:"status"`
+	Description       string `json:"description"`
+	StatusDescription string `json:"statusDescription"`
+}
+
+type StreamDeployment struct {
+	hal.Resource
+	StreamName  string `json:"streamName"`
+	DslText     string `json:"dslText"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+
+	// DeploymentProperties holds the deployment properties encoded as a JSON object.
+	DeploymentProperties string `json:"deploymentProperties"`
+}
+
+// Properties returns the deployment properties of the stream. Properties nested under an application name are
+// returned with keys prefixed by the application name and a period.
+func (d *StreamDeployment) Properties() (map[string]string, error) {
+	properties := map[string]string{}
+	if d.DeploymentProperties == "" {
+		return properties, nil
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(d.DeploymentProperties), &decoded); err != nil {
+		return nil, fmt.Errorf("Invalid deployment properties of stream '%s': %s", d.StreamName, err)
+	}
+	flatten("", decoded, properties)
+	return properties, nil
+}
+
+func flatten(prefix string, values map[string]interface{}, properties map[string]string) {
+	for key, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flatten(prefix+key+".", v, properties)
+		case string:
+			properties[prefix+key] = v
+		case nil:
+			properties[prefix+key] = ""
+		default:
+			encoded, _ := json.Marshal(v)
+			properties[prefix+key] = string(encoded)
+		}
+	}
+}
+
+// StreamRelease is a release of a stream deployed using Skipper.
+type StreamRelease struct {
+	Name         string          `json:"name"`
+	Version      int             `json:"version"`
+	Info         ReleaseInfo     `json:"info"`
+	Pkg          Package         `json:"pkg"`
+	ConfigValues ConfigValues    `json:"configValues"`
+	Manifest     ReleaseManifest `json:"manifest"`
+	PlatformName string          `json:"platformName"`
+}
+
+type ReleaseInfo struct {
+	Status        ReleaseStatus `json:"status"`
+	FirstDeployed hal.Time      `json:"firstDeployed"`
+	LastDeployed  hal.Time      `json:"lastDeployed"`
+	Deleted       hal.Time      `json:"deleted"`
+	Description   string        `json:"description"`
+}
+
+type ReleaseStatus struct {
+	StatusCode     string `json:"statusCode"`
+	PlatformStatus string `json:"platformStatus"`
+}
+
+type Package struct {
+	Metadata PackageMetadata `json:"metadata"`
+}
+
+type PackageMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type ConfigValues struct {
+	Raw string `json:"raw"`
+}
+
+type ReleaseManifest struct {
+	Data string `json:"data"`
+}
+
+// Platform is a platform to which streams may be deployed or on which tasks may be launched.
+type Platform struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+func (c *client) StreamDefinitions(ctx context.Context) ([]StreamDefinition, error) {
+	streams := []StreamDefinition{}
+	return streams, c.api.Collect(ctx, "streams/definitions", nil, "", &streams)
+}
+
+func (c *client) StreamDefinition(ctx context.Context, name string) (*StreamDefinition, error) {
+	var stream StreamDefinition
+	if err := c.api.Get(ctx, "streams/definitions/definition", map[string]string{"name": name}, &stream); err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func (c *client) CreateStream(ctx context.Context, name string, definition string, description string, deploy bool) (*StreamDefinition, error) {
+	var stream StreamDefinition
+	err := c.api.Send(ctx, http.MethodPost, "streams/definitions", nil, func(request *httpclient.Request) {
+		request.WithFormBody(form("name", name, "definition", definition, "description", description, "deploy", fmt.Sprint(deploy)))
+	}, &stream)
+	if err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func (c *client) DestroyStream(ctx context.Context, name string) error {
+	return c.api.Delete(ctx, "streams/definitions/definition", map[string]string{"name": name})
+}
+
+func (c *client) StreamDeployment(ctx context.Context, name string) (*StreamDeployment, error) {
+	var deployment StreamDeployment
+	if err := c.api.Get(ctx, "streams/deployments/deployment", map[string]string{"name": name}, &deployment); err != nil {
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+func (c *client) DeployStream(ctx context.Context, name string, properties map[string]string) error {
+	return c.api.Send(ctx, http.MethodPost, "streams/deployments/deployment", map[string]string{"name": name}, hal.JSONBody(nonNil(properties)), nil)
+}
+
+func (c *client) UndeployStream(ctx context.Context, name string) error {
+	return c.api.Delete(ctx, "streams/deployments/deployment", map[string]string{"name": name})
+}
+
+func (c *client) UpdateStream(ctx context.Context, name string, properties map[string]string) error {
+	update := map[string]interface{}{
+		"releaseName":       name,
+		"packageIdentifier": map[string]string{"packageName": name},
+		"updateProperties":  nonNil(properties),
+	}
+	return c.api.Send(ctx, http.MethodPost, "streams/deployments/update", map[string]string{"name": name}, hal.JSONBody(update), nil)
+}
+
+func (c *client) RollbackStream(ctx context.Context, name string, version int) error {
+	return c.api.Send(ctx, http.MethodPost, "streams/deployments/rollback", map[string]string{"name": name, "version": itoa(version)}, nil, nil)
+}
+
+func (c *client) StreamHistory(ctx context.Context, name string) ([]StreamRelease, error) {
+	releases := []StreamRelease{}
+	if err := c.api.Get(ctx, "streams/deployments/history", map[string]string{"name": name}, &releases); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+	return releases, nil
+}
+
+func (c *client) StreamManifest(ctx context.Context, name string, version int) (string, error) {
+	href, err := c.api.Href(ctx, "streams/deployments/manifest", map[string]string{"name": name, "version": itoa(version)})
+	if err != nil {
+		return "", err
+	}
+	return c.hal.Text(ctx, httpclient.NewRequest(http.MethodGet, href))
+}
+
+func (c *client) ScaleStreamApp(ctx context.Context, streamName string, appLabel string, count int, properties map[string]string) error {
+	params := map[string]string{"streamName": streamName, "appName": appLabel, "count": itoa(count)}
+	return c.api.Send(ctx, http.MethodPost, "streams/deployments/scale", params, hal.JSONBody(nonNil(properties)), nil)
+}
+
+func (c *client) StreamPlatforms(ctx context.Context) ([]Platform, error) {
+	platforms := []Platform{}
+	if err := c.api.Get(ctx, "streams/deployments/platform/list", nil, &platforms); err != nil {
+		return nil, err
+	}
+	return platforms, nil
+}
+
+// nonNil returns the given properties or, if they are nil, an empty map, which encodes as an empty JSON object.
+func nonNil(properties map[string]string) map[string]string {
+	if properties == nil {
+		return map[string]string{}
+	}
+	return properties
+}
+
+func sortedKeys(properties map[string]string) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
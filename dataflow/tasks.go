@@ -0,0 +1,230 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dataflow
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// PlatformNameProperty is the task launch property which selects the platform on which a task is launched.
+const PlatformNameProperty = "spring.cloud.dataflow.task.platformName"
+
+type TaskDefinition struct {
+	hal.Resource
+	Name              string         `json:"name"`
+	DslText           string         `json:"dslText"`
+	Description       string         `json:"description"`
+	Composed          bool           `json:"composed"`
+	Status            string         `json:"status"`
+	LastTaskExecution *TaskExecution `json:"lastTaskExecution"`
+}
+
+type TaskExecution struct {
+	hal.Resource
+	ExecutionId          int64             `json:"executionId"`
+	ExitCode             *int              `json:"exitCode"`
+	TaskName             string            `json:"taskName"`
+	StartTime            hal.Time          `json:"startTime"`
+	EndTime              hal.Time          `json:"endTime"`
+	ExitMessage          string            `json:"exitMessage"`
+	Arguments            []string          `json:"arguments"`
+	JobExecutionIds      []int64           `json:"jobExecutionIds"`
+	ErrorMessage         string            `json:"errorMessage"`
+	ExternalExecutionId  string            `json:"externalExecutionId"`
+	ParentExecutionId    *int64            `json:"parentExecutionId"`
+	ResourceUrl          string            `json:"resourceUrl"`
+	AppProperties        map[string]string `json:"appProperties"`
+	DeploymentProperties map[string]string `json:"deploymentProperties"`
+	TaskExecutionStatus  string            `json:"taskExecutionStatus"`
+	PlatformName         string            `json:"platformName"`
+}
+
+// Task execution statuses reported by the server.
+const (
+	TaskExecutionRunning  = "RUNNING"
+	TaskExecutionComplete = "COMPLETE"
+	TaskExecutionError    = "ERROR"
+	TaskExecutionUnknown  = "UNKNOWN"
+)
+
+// TaskExecutionPage is a page of task executions.
+type TaskExecutionPage struct {
+	Executions []TaskExecution
+	Page       *hal.Page
+}
+
+// TaskLaunch holds the command line arguments and deployment properties with which a task is launched and the
+// platform on which it is launched. The platform may be empty to use the default platform.
+type TaskLaunch struct {
+	Arguments  []string
+	Properties map[string]string
+	Platform   string
+}
+
+func (l TaskLaunch) form() url.Values {
+	properties := map[string]string{}
+	for key, value := range l.Properties {
+		properties[key] = value
+	}
+	if l.Platform != "" {
+		properties[PlatformNameProperty] = l.Platform
+	}
+
+	values := url.Values{}
+	if len(properties) > 0 {
+		values.Set("properties", formatProperties(properties))
+	}
+	if len(l.Arguments) > 0 {
+		values.Set("arguments", formatArguments(l.Arguments))
+	}
+	return values
+}
+
+// formatArguments joins the given arguments with spaces, quoting any argument which contains whitespace so that the
+// server does not split it.
+func formatArguments(arguments []string) string {
+	quoted := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		if strings.IndexFunc(argument, unicode.IsSpace) >= 0 {
+			argument = `"` + argument + `"`
+		}
+		quoted = append(quoted, argument)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func (c *client) TaskDefinitions(ctx context.Context) ([]TaskDefinition, error) {
+	tasks := []TaskDefinition{}
+	return tasks, c.api.Collect(ctx, "tasks/definitions", nil, "", &tasks)
+}
+
+func (c *client) TaskDefinition(ctx context.Context, name string) (*TaskDefinition, error) {
+	var task TaskDefinition
+	if err := c.api.Get(ctx, "tasks/definitions/definition", map[string]string{"name": name}, &task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (c *client) CreateTask(ctx context.Context, name string, definition string, description string) (*TaskDefinition, error) {
+	var task TaskDefinition
+	err := c.api.Send(ctx, http.MethodPost, "tasks/definitions", nil, func(request *httpclient.Request) {
+		request.WithFormBody(form("name", name, "definition", definition, "description", description))
+	}, &task)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (c *client) DestroyTask(ctx context.Context, name string, cleanup bool) error {
+	return c.api.Send(ctx, http.MethodDelete, "tasks/definitions/definition", map[string]string{"name": name}, func(request *httpclient.Request) {
+		if cleanup {
+			request.WithQuery("cleanup", "true")
+		}
+	}, nil)
+}
+
+func (c *client) LaunchTask(ctx context.Context, name string, launch TaskLaunch) (int64, error) {
+	values := launch.form()
+	values.Set("name", name)
+
+	var executionId int64
+	err := c.api.Send(ctx, http.MethodPost, "tasks/executions", nil, func(request *httpclient.Request) {
+		request.WithFormBody(values)
+	}, &executionId)
+	return executionId, err
+}
+
+func (c *client) TaskExecutions(ctx context.Context, taskName string, pageSize int) hal.Iterator {
+	href, err := c.taskExecutionsHref(ctx, taskName)
+	if err != nil {
+		return errorIterator{err}
+	}
+	if pageSize > 0 {
+		href = addQuery(href, "size", itoa(pageSize))
+	}
+	return c.hal.Items(ctx, href, "")
+}
+
+func (c *client) TaskExecutionPage(ctx context.Context, taskName string, page int, size int) (*TaskExecutionPage, error) {
+	href, err := c.taskExecutionsHref(ctx, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource hal.PagedResource
+	request := httpclient.NewRequest(http.MethodGet, href).WithQuery("page", itoa(page)).WithQuery("size", itoa(size))
+	if err := c.hal.Do(ctx, request, &resource); err != nil {
+		return nil, err
+	}
+
+	executions := []TaskExecution{}
+	if err := hal.DecodeEmbedded(resource, "", &executions); err != nil {
+		return nil, err
+	}
+	return &TaskExecutionPage{Executions: executions, Page: resource.Page}, nil
+}
+
+func (c *client) taskExecutionsHref(ctx context.Context, taskName string) (string, error) {
+	if taskName == "" {
+		return c.api.Href(ctx, "tasks/executions", nil)
+	}
+	return c.api.Href(ctx, "tasks/executions/name", map[string]string{"name": taskName})
+}
+
+func (c *client) TaskExecution(ctx context.Context, id int64) (*TaskExecution, error) {
+	var execution TaskExecution
+	if err := c.api.Get(ctx, "tasks/executions/execution", map[string]string{"id": formatId(id)}, &execution); err != nil {
+		return nil, err
+	}
+	return &execution, nil
+}
+
+func (c *client) CleanupTaskExecution(ctx context.Context, id int64, removeData bool) error {
+	action := "CLEANUP"
+	if removeData {
+		action = "CLEANUP,REMOVE_DATA"
+	}
+	return c.api.Send(ctx, http.MethodDelete, "tasks/executions/execution", map[string]string{"id": formatId(id)}, func(request *httpclient.Request) {
+		request.WithQuery("action", action)
+	}, nil)
+}
+
+func (c *client) TaskLog(ctx context.Context, externalExecutionId string, platform string) (string, error) {
+	params := map[string]string{"taskExternalExecutionId": externalExecutionId}
+	if platform != "" {
+		params["platformName"] = platform
+	}
+	href, err := c.api.Href(ctx, "tasks/logs", params)
+	if err != nil {
+		return "", err
+	}
+	return c.hal.Text(ctx, httpclient.NewRequest(http.MethodGet, href))
+}
+
+func (c *client) TaskPlatforms(ctx context.Context) ([]Platform, error) {
+	platforms := []Platform{}
+	return platforms, c.api.Collect(ctx, "tasks/platforms", nil, "", &platforms)
+}
@@ -4,7 +4,7 @@ if [ ! -z ${DEBUG} ]; then
     set -x
 fi
 
-declare -a SCS_COMMANDS=("dataflow-shell")
+declare -a SCS_COMMANDS=("dataflow-shell" "dataflow-shell-fetch" "dataflow-streams" "dataflow-stream" "dataflow-stream-create" "dataflow-stream-deploy" "dataflow-stream-undeploy" "dataflow-stream-destroy" "dataflow-stream-update" "dataflow-stream-rollback" "dataflow-stream-scale" "dataflow-stream-wait" "dataflow-stream-history" "dataflow-tasks" "dataflow-task-create" "dataflow-task-destroy" "dataflow-task-launch" "dataflow-task-executions" "dataflow-task-execution" "dataflow-task-cleanup" "dataflow-services" "dataflow-wait" "dataflow-cache")
 CMD_DOC_FILENAME=cli.md
 
 echo "# Spring Cloud Dataflow for PCF CF CLI Plugin Docs
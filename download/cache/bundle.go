@@ -0,0 +1,382 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	bundleManifestName     = "manifest.json"
+	bundleFilesDirectory   = "files"
+	bundleStagingDirPrefix = ".import"
+	bundleBackupDirectory  = ".previous"
+)
+
+// bundleManifest describes the contents of a cache bundle. Each file in the bundle is described by one or more
+// entries, since distinct URLs with the same final path segment share a cached file.
+type bundleManifest struct {
+	Entries []bundleEntry `json:"entries"`
+}
+
+type bundleEntry struct {
+	Url      string `json:"url"`
+	Etag     string `json:"etag,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	Sha256   string `json:"sha256"`
+}
+
+// Export writes the cached files, together with their etags and checksums, to the given writer as a gzipped tar
+// bundle and returns the number of cache entries written.
+func (f *fileCache) Export(writer io.Writer) (int, error) {
+	manifest, err := f.manifest()
+	if err != nil {
+		return 0, err
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return 0, err // Should never get here
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name: bundleManifestName,
+		Mode: cacheEntriesFilePerm,
+		Size: int64(len(manifestBytes)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if _, err = tarWriter.Write(manifestBytes); err != nil {
+		return 0, err
+	}
+
+	written := map[string]struct{}{}
+	for _, entry := range manifest.Entries {
+		fileName, _ := bundleFileName(entry.Url)
+		if _, ok := written[fileName]; ok {
+			continue
+		}
+		if err = writeBundleFile(tarWriter, path.Join(f.downloadsDirectory, fileName), fileName); err != nil {
+			return 0, err
+		}
+		written[fileName] = struct{}{}
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return 0, err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return 0, err
+	}
+
+	return len(manifest.Entries), nil
+}
+
+// Import reads a bundle written by Export from the given reader and adds its files, together with their etags and
+// checksums, to the cache. Every file in the bundle is checked against its checksums before any cache entry is
+// added, so a bundle which fails verification leaves the cache unchanged. If adding the cache entries fails, the
+// entries and files already added are rolled back. The number of cache entries added is returned.
+func (f *fileCache) Import(reader io.Reader) (int, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid cache bundle: %s", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return 0, fmt.Errorf("Invalid cache bundle: %s", err)
+	}
+	if header.Name != bundleManifestName {
+		return 0, fmt.Errorf("Invalid cache bundle: %s not found", bundleManifestName)
+	}
+
+	var manifest bundleManifest
+	if err = json.NewDecoder(tarReader).Decode(&manifest); err != nil {
+		return 0, fmt.Errorf("Invalid cache bundle manifest: %s", err)
+	}
+
+	expected := map[string][]bundleEntry{}
+	for _, entry := range manifest.Entries {
+		fileName, err := bundleFileName(entry.Url)
+		if err != nil {
+			return 0, err
+		}
+		expected[fileName] = append(expected[fileName], entry)
+	}
+
+	stagingDir, err := ioutil.TempDir(f.downloadsDirectory, bundleStagingDirPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	verified := map[string]struct{}{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("Invalid cache bundle: %s", err)
+		}
+
+		fileName := strings.TrimPrefix(header.Name, bundleFilesDirectory+"/")
+		entries, ok := expected[fileName]
+		if !ok || header.Name != path.Join(bundleFilesDirectory, fileName) {
+			return 0, fmt.Errorf("Invalid cache bundle: unexpected file '%s'", header.Name)
+		}
+
+		if err = stageBundleFile(tarReader, path.Join(stagingDir, fileName), entries); err != nil {
+			return 0, err
+		}
+		verified[fileName] = struct{}{}
+	}
+
+	for fileName := range expected {
+		if _, ok := verified[fileName]; !ok {
+			return 0, fmt.Errorf("Invalid cache bundle: file '%s' not found", path.Join(bundleFilesDirectory, fileName))
+		}
+	}
+
+	rollback := &bundleRollback{cache: f, backupDir: path.Join(stagingDir, bundleBackupDirectory)}
+	if err = rollback.register(manifest.Entries); err != nil {
+		return 0, rollback.restore(err)
+	}
+	for fileName := range verified {
+		if err = rollback.install(path.Join(stagingDir, fileName), fileName); err != nil {
+			return 0, rollback.restore(err)
+		}
+	}
+
+	return len(manifest.Entries), nil
+}
+
+// bundleRollback adds the entries and files of a bundle to a cache, recording what they replace so that the cache
+// can be restored if adding them fails partway.
+type bundleRollback struct {
+	cache     *fileCache
+	backupDir string
+	etags     map[string]string
+	checksums map[string]string
+	installed []string
+	backedUp  map[string]struct{}
+}
+
+// register sets the etags and checksums of the given entries, recording the values they replace.
+func (r *bundleRollback) register(entries []bundleEntry) error {
+	r.etags = map[string]string{}
+	r.checksums = map[string]string{}
+	for _, entry := range entries {
+		if entry.Etag != "" {
+			if _, ok := r.etags[entry.Url]; !ok {
+				previous, err := r.cache.etagHelper.GetETagForUrl(entry.Url)
+				if err != nil {
+					return err
+				}
+				r.etags[entry.Url] = previous
+			}
+			if err := r.cache.etagHelper.SetEtagForUrl(entry.Url, entry.Etag); err != nil {
+				return err
+			}
+		}
+		if entry.Checksum != "" {
+			if _, ok := r.checksums[entry.Url]; !ok {
+				previous, err := r.cache.checksumHelper.GetChecksumForUrl(entry.Url)
+				if err != nil {
+					return err
+				}
+				r.checksums[entry.Url] = previous
+			}
+			if err := r.cache.checksumHelper.SetChecksumForUrl(entry.Url, entry.Checksum); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// install moves the given staged file into the cache, first moving aside any cached file it replaces.
+func (r *bundleRollback) install(stagedPath string, fileName string) error {
+	cachedPath := path.Join(r.cache.downloadsDirectory, fileName)
+	if fileExists(cachedPath) {
+		if err := os.MkdirAll(r.backupDir, cacheDirectoryPerm); err != nil {
+			return err
+		}
+		if err := os.Rename(cachedPath, path.Join(r.backupDir, fileName)); err != nil {
+			return err
+		}
+		if r.backedUp == nil {
+			r.backedUp = map[string]struct{}{}
+		}
+		r.backedUp[fileName] = struct{}{}
+	}
+	if err := os.Rename(stagedPath, cachedPath); err != nil {
+		return err
+	}
+	r.installed = append(r.installed, fileName)
+	return nil
+}
+
+// restore undoes the changes made so far, as far as possible, and returns the given error.
+func (r *bundleRollback) restore(err error) error {
+	for _, fileName := range r.installed {
+		_ = os.Remove(path.Join(r.cache.downloadsDirectory, fileName))
+	}
+	for fileName := range r.backedUp {
+		_ = os.Rename(path.Join(r.backupDir, fileName), path.Join(r.cache.downloadsDirectory, fileName))
+	}
+	for url, etag := range r.etags {
+		_ = r.cache.etagHelper.SetEtagForUrl(url, etag)
+	}
+	for url, checksum := range r.checksums {
+		_ = r.cache.checksumHelper.SetChecksumForUrl(url, checksum)
+	}
+	return err
+}
+
+func (f *fileCache) manifest() (*bundleManifest, error) {
+	etagUrls, err := f.etagHelper.Urls()
+	if err != nil {
+		return nil, err
+	}
+	checksumUrls, err := f.checksumHelper.Urls()
+	if err != nil {
+		return nil, err
+	}
+
+	urls := map[string]struct{}{}
+	for _, url := range append(etagUrls, checksumUrls...) {
+		urls[url] = struct{}{}
+	}
+	sortedUrls := []string{}
+	for url := range urls {
+		sortedUrls = append(sortedUrls, url)
+	}
+	sort.Strings(sortedUrls)
+
+	manifest := &bundleManifest{Entries: []bundleEntry{}}
+	calculator := &checksumCalculator{}
+	for _, url := range sortedUrls {
+		fileName, err := bundleFileName(url)
+		if err != nil {
+			continue
+		}
+		filePath := path.Join(f.downloadsDirectory, fileName)
+		if !fileExists(filePath) {
+			continue
+		}
+
+		etag, err := f.etagHelper.GetETagForUrl(url)
+		if err != nil {
+			return nil, err
+		}
+		checksum, err := f.checksumHelper.GetChecksumForUrl(url)
+		if err != nil {
+			return nil, err
+		}
+		sha256Checksum, err := calculator.CalculateChecksum(filePath, sha256.New())
+		if err != nil {
+			return nil, err
+		}
+
+		manifest.Entries = append(manifest.Entries, bundleEntry{
+			Url:      url,
+			Etag:     etag,
+			Checksum: checksum,
+			Sha256:   sha256Checksum,
+		})
+	}
+	return manifest, nil
+}
+
+func writeBundleFile(tarWriter *tar.Writer, filePath string, fileName string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name:    path.Join(bundleFilesDirectory, fileName),
+		Mode:    cacheEntriesFilePerm,
+		Size:    fileInfo.Size(),
+		ModTime: fileInfo.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tarWriter, file)
+	return err
+}
+
+// stageBundleFile writes a bundled file to the given path and checks its contents against the checksums of the
+// given entries. A recorded checksum may be either a SHA-256 or a SHA-1 checksum.
+func stageBundleFile(reader io.Reader, filePath string, entries []bundleEntry) error {
+	sha256Hash := sha256.New()
+	sha1Hash := sha1.New()
+
+	err := writeDataToNamedFile(ioutil.NopCloser(io.TeeReader(reader, io.MultiWriter(sha256Hash, sha1Hash))), filePath)
+	if err != nil {
+		return err
+	}
+
+	sha256Checksum := hex.EncodeToString(sha256Hash.Sum(nil))
+	sha1Checksum := hex.EncodeToString(sha1Hash.Sum(nil))
+	for _, entry := range entries {
+		if entry.Sha256 != sha256Checksum {
+			return fmt.Errorf("Cache bundle file for '%s' checksum does not match manifest value '%s'", entry.Url, entry.Sha256)
+		}
+		if entry.Checksum != "" && entry.Checksum != sha256Checksum && entry.Checksum != sha1Checksum {
+			return fmt.Errorf("Cache bundle file for '%s' checksum does not match recorded value '%s'", entry.Url, entry.Checksum)
+		}
+	}
+	return nil
+}
+
+// bundleFileName returns the name of the cached file of the given URL. Names starting with "." are rejected, since
+// they include "." and "..", the cache's index files, and its staging directories.
+func bundleFileName(url string) (string, error) {
+	fileName := path.Base(createFilePathForDownloadFile(url, "/"))
+	if fileName == "/" || strings.HasPrefix(fileName, ".") || strings.Contains(fileName, `\`) {
+		return "", fmt.Errorf("Invalid cache bundle entry URL '%s'", url)
+	}
+	return fileName, nil
+}
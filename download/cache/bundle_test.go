@@ -0,0 +1,297 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cache_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download/cache"
+)
+
+var _ = Describe("Bundle", func() {
+	const (
+		url1      = "http://host/path/shell-1.jar"
+		url2      = "http://host/path/shell-2.jar"
+		content1  = "shell 1 content"
+		content2  = "shell 2 content"
+		etag1     = "etag1"
+		checksum1 = "0ab6f3f5d0c8f4bfef7e1d8d64c0dfe4e7f6dbb1ec0f41b2ef5ff2a6c65a1aa2"
+	)
+
+	var (
+		cfDir  string
+		bundle *bytes.Buffer
+		count  int
+		err    error
+	)
+
+	sha256Of := func(content string) string {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(content)))
+	}
+
+	store := func(url string, content string, etag string) {
+		c, err := cache.NewCache(GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Entry(url).Store(ioutil.NopCloser(bytes.NewReader([]byte(content))), etag, sha256Of(content), sha256.New())).To(Succeed())
+	}
+
+	writeBundle := func(manifest string, files map[string]string) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		gzipWriter := gzip.NewWriter(buf)
+		tarWriter := tar.NewWriter(gzipWriter)
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: "manifest.json", Mode: 0644, Size: int64(len(manifest))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(manifest))
+		Expect(err).NotTo(HaveOccurred())
+		for name, content := range files {
+			Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+			_, err := tarWriter.Write([]byte(content))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+		return buf
+	}
+
+	importBundle := func() {
+		c, cacheErr := cache.NewCache(GinkgoWriter)
+		Expect(cacheErr).NotTo(HaveOccurred())
+		count, err = c.Import(bundle)
+	}
+
+	BeforeEach(func() {
+		cfDir = path.Join(testCacheUnderCfHomeFolder, ".cf")
+		Expect(os.RemoveAll(cfDir)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cfDir)).To(Succeed())
+	})
+
+	Context("when cached files are exported", func() {
+		BeforeEach(func() {
+			store(url1, content1, etag1)
+			store(url2, content2, "")
+
+			c, err := cache.NewCache(GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			bundle = &bytes.Buffer{}
+			count, err = c.Export(bundle)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should export every cache entry", func() {
+			Expect(count).To(Equal(2))
+		})
+
+		Context("when the bundle is imported into an empty cache", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(cfDir)).To(Succeed())
+			})
+
+			JustBeforeEach(importBundle)
+
+			It("should import every cache entry", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(2))
+			})
+
+			It("should restore the cached files and etags", func() {
+				c, err := cache.NewCache(GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				filePath, etag, err := c.Entry(url1).Retrieve()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filePath).To(HaveSuffix("shell-1.jar"))
+				Expect(etag).To(Equal(etag1))
+				Expect(c.Entry(url1).Verify(sha256Of(content1), sha256.New())).To(Succeed())
+
+				filePath, etag, err = c.Entry(url2).Retrieve()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filePath).To(HaveSuffix("shell-2.jar"))
+				Expect(etag).To(BeEmpty())
+				Expect(c.Entry(url2).Verify(sha256Of(content2), sha256.New())).To(Succeed())
+			})
+
+			It("should restore the recorded checksums", func() {
+				c, err := cache.NewCache(GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				if cacheEntry, ok := c.Entry(url2).(cache.FieldGetter); ok {
+					checksum, err := cacheEntry.GetChecksumHelper().GetChecksumForUrl(url2)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(checksum).To(Equal(sha256Of(content2)))
+				} else {
+					Fail("cache entry did not implement FieldGetter")
+				}
+			})
+
+			It("should not leave staging files behind", func() {
+				files, err := ioutil.ReadDir(path.Join(cfDir, "spring-cloud-dataflow-for-pcf", "cache"))
+				Expect(err).NotTo(HaveOccurred())
+				for _, file := range files {
+					Expect(file.IsDir()).To(BeFalse())
+				}
+			})
+		})
+	})
+
+	Context("when a bundled file does not match its manifest checksum", func() {
+		BeforeEach(func() {
+			bundle = writeBundle(fmt.Sprintf(`{"entries":[{"url":%q,"sha256":%q}]}`, url1, sha256Of(content1)),
+				map[string]string{"files/shell-1.jar": "tampered content"})
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError(fmt.Sprintf("Cache bundle file for '%s' checksum does not match manifest value '%s'", url1, sha256Of(content1))))
+		})
+
+		It("should leave the cache unchanged", func() {
+			c, err := cache.NewCache(GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			filePath, _, err := c.Entry(url1).Retrieve()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filePath).To(BeEmpty())
+		})
+	})
+
+	Context("when a bundled file does not match its recorded checksum", func() {
+		BeforeEach(func() {
+			bundle = writeBundle(fmt.Sprintf(`{"entries":[{"url":%q,"checksum":%q,"sha256":%q}]}`, url1, checksum1, sha256Of(content1)),
+				map[string]string{"files/shell-1.jar": content1})
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError(fmt.Sprintf("Cache bundle file for '%s' checksum does not match recorded value '%s'", url1, checksum1)))
+		})
+	})
+
+	Context("when a bundled file is missing", func() {
+		BeforeEach(func() {
+			bundle = writeBundle(fmt.Sprintf(`{"entries":[{"url":%q,"sha256":%q}]}`, url1, sha256Of(content1)), nil)
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Invalid cache bundle: file 'files/shell-1.jar' not found"))
+		})
+	})
+
+	Context("when the bundle contains an unexpected file", func() {
+		BeforeEach(func() {
+			bundle = writeBundle(`{"entries":[]}`, map[string]string{"files/../../escape": content1})
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Invalid cache bundle: unexpected file 'files/../../escape'"))
+		})
+	})
+
+	Context("when the manifest contains an invalid URL", func() {
+		BeforeEach(func() {
+			bundle = writeBundle(`{"entries":[{"url":"http://host/.."}]}`, nil)
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Invalid cache bundle entry URL 'http://host/..'"))
+		})
+	})
+
+	Context("when the manifest contains a URL naming a cache index file", func() {
+		It("should reject it", func() {
+			for _, url := range []string{"http://host/.cachedata", "http://host/.checksumdata", "http://host/.import123"} {
+				bundle = writeBundle(fmt.Sprintf(`{"entries":[{"url":%q,"sha256":%q}]}`, url, sha256Of(content1)),
+					map[string]string{"files/" + path.Base(url): content1})
+				importBundle()
+				Expect(err).To(MatchError(fmt.Sprintf("Invalid cache bundle entry URL '%s'", url)))
+			}
+		})
+	})
+
+	Context("when adding a bundled file to the cache fails partway", func() {
+		const oldContent = "old shell 1 content"
+
+		BeforeEach(func() {
+			store(url1, oldContent, "old-etag")
+			// A non-empty directory in place of shell-2.jar cannot be replaced by the bundled file.
+			Expect(os.MkdirAll(path.Join(cfDir, "spring-cloud-dataflow-for-pcf", "cache", "shell-2.jar", "x"), 0755)).To(Succeed())
+
+			bundle = writeBundle(fmt.Sprintf(`{"entries":[{"url":%q,"etag":%q,"checksum":%q,"sha256":%q},{"url":%q,"etag":"etag2","sha256":%q}]}`,
+				url1, etag1, sha256Of(content1), sha256Of(content1), url2, sha256Of(content2)),
+				map[string]string{"files/shell-1.jar": content1, "files/shell-2.jar": content2})
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return the error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should restore the cached files and index entries", func() {
+			c, err := cache.NewCache(GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			filePath, etag, err := c.Entry(url1).Retrieve()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filePath).To(HaveSuffix("shell-1.jar"))
+			Expect(etag).To(Equal("old-etag"))
+			Expect(c.Entry(url1).Verify(sha256Of(oldContent), sha256.New())).To(Succeed())
+
+			_, etag, err = c.Entry(url2).Retrieve()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(etag).To(BeEmpty())
+
+			if cacheEntry, ok := c.Entry(url1).(cache.FieldGetter); ok {
+				checksum, err := cacheEntry.GetChecksumHelper().GetChecksumForUrl(url1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checksum).To(Equal(sha256Of(oldContent)))
+			} else {
+				Fail("cache entry did not implement FieldGetter")
+			}
+		})
+	})
+
+	Context("when the bundle is not a gzipped tar file", func() {
+		BeforeEach(func() {
+			bundle = bytes.NewBufferString("not a bundle")
+		})
+
+		JustBeforeEach(importBundle)
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Invalid cache bundle: gzip: invalid header"))
+		})
+	})
+})
@@ -28,6 +28,7 @@ import (
 
 const (
 	cacheEntriesFileName = ".cachedata"
+	checksumsFileName    = ".checksumdata"
 	cfHomeProperty       = "CF_HOME"
 	homeProperty         = "HOME"
 	cfDataDirectory      = ".cf"
@@ -46,6 +47,7 @@ type Cache interface {
 type fileCache struct {
 	downloadsDirectory string
 	etagHelper         EtagHelper
+	checksumHelper     ChecksumHelper
 	progressWriter     io.Writer
 }
 
@@ -55,6 +57,7 @@ func (f *fileCache) Entry(Url string) CacheEntry {
 		downloadFile:       createFilePathForDownloadFile(Url, f.downloadsDirectory),
 		checksumCalculator: &checksumCalculator{},
 		etagHelper:         f.etagHelper,
+		checksumHelper:     f.checksumHelper,
 		progressWriter:     f.progressWriter,
 	}
 }
@@ -71,9 +74,16 @@ func NewCache(progressWriter io.Writer) (*fileCache, error) {
 		return nil, err
 	}
 
+	checksumsFile := path.Join(downloadsDir, checksumsFileName)
+	checksumHelper, err := NewChecksumIndex(checksumsFile)
+	if err != nil {
+		return nil, err
+	}
+
 	return &fileCache{
 		downloadsDirectory: downloadsDir,
 		etagHelper:         etagHelper,
+		checksumHelper:     checksumHelper,
 		progressWriter:     progressWriter,
 	}, nil
 }
@@ -106,6 +116,15 @@ func (c *checksumCalculator) CalculateChecksum(filePath string, hash hash.Hash)
 type EtagHelper interface {
 	GetETagForUrl(url string) (string, error)
 	SetEtagForUrl(url string, etag string) error
+	Urls() ([]string, error)
+}
+
+// Place checksum recording functionality inside an interface to help with testing
+//go:generate counterfeiter -o ../downloadfakes/fake_checksumhelper.go . ChecksumHelper
+type ChecksumHelper interface {
+	GetChecksumForUrl(url string) (string, error)
+	SetChecksumForUrl(url string, checksum string) error
+	Urls() ([]string, error)
 }
 
 // CacheEntry provides a cache of a single file and its etag.
@@ -118,6 +137,10 @@ type CacheEntry interface {
 	// If the file contents cannot be written or the etag associated with the file, an error is returned.
 	// The file contents are checked against the given checksum using the given hash and an error is returned if the check fails.
 	Store(contents io.ReadCloser, etag string, checksum string, hashFunc hash.Hash) error
+
+	// Verify checks the cached file contents against the given checksum using the given hash. An error is returned
+	// if the file has not been cached or if the check fails.
+	Verify(checksum string, hashFunc hash.Hash) error
 }
 
 type fileCacheEntry struct {
@@ -125,6 +148,7 @@ type fileCacheEntry struct {
 	downloadFile       string
 	checksumCalculator ChecksumCalculator
 	etagHelper         EtagHelper
+	checksumHelper     ChecksumHelper
 	progressWriter     io.Writer
 }
 
@@ -163,6 +187,22 @@ func (f *fileCacheEntry) Store(contents io.ReadCloser, etag string, checksum str
 		}
 	}
 
+	return f.checksumHelper.SetChecksumForUrl(f.downloadUrl, checksum)
+}
+
+func (f *fileCacheEntry) Verify(checksum string, hash hash.Hash) error {
+	if !fileExists(f.downloadFile) {
+		return fmt.Errorf("File '%s' has not been cached", f.downloadUrl)
+	}
+
+	calculatedCheckSum, err := f.checksumCalculator.CalculateChecksum(f.downloadFile, hash)
+	if err != nil {
+		return err
+	}
+
+	if checksum != calculatedCheckSum {
+		return fmt.Errorf("Cached file '%s' checksum does not match supplied value '%s'", f.downloadFile, checksum)
+	}
 	return nil
 }
 
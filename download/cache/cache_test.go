@@ -239,6 +239,7 @@ var _ = Describe("CacheEntry", func() {
 	var (
 		fakeChecksumCalculator *downloadfakes.FakeChecksumCalculator
 		fakeEtagHelper         *downloadfakes.FakeEtagHelper
+		fakeChecksumHelper     *downloadfakes.FakeChecksumHelper
 		downloadsCache         cache.Cache
 		cacheEntry             cache.CacheEntry
 		downloadContent        io.ReadCloser
@@ -260,6 +261,8 @@ var _ = Describe("CacheEntry", func() {
 
 		fakeEtagHelper = &downloadfakes.FakeEtagHelper{}
 
+		fakeChecksumHelper = &downloadfakes.FakeChecksumHelper{}
+
 		etagArgument = etagValue
 
 		testError = errors.New(errMessage)
@@ -277,6 +280,12 @@ var _ = Describe("CacheEntry", func() {
 		})
 	})
 
+	Describe("Verify", func() {
+		It("should return an error when the file has not been cached", func() {
+			Expect(cacheEntry.Verify(checksumValue, hashFunc)).To(MatchError(fmt.Sprintf("File '%s' has not been cached", urlValue)))
+		})
+	})
+
 	Describe("Store", func() {
 		JustBeforeEach(func() {
 			err = cacheEntry.Store(downloadContent, etagArgument, checksumValue, hashFunc)
@@ -293,6 +302,24 @@ var _ = Describe("CacheEntry", func() {
 				Expect(etag).To(Equal(etagValue))
 			})
 
+			It("should record the checksum", func() {
+				if cacheEntry, ok := cacheEntry.(cache.FieldGetter); ok {
+					checksum, err := cacheEntry.GetChecksumHelper().GetChecksumForUrl(urlValue)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(checksum).To(Equal(checksumValue))
+				} else {
+					Fail("cache entry did not implement FieldGetter")
+				}
+			})
+
+			It("should verify the stored file", func() {
+				Expect(cacheEntry.Verify(checksumValue, sha256.New())).To(Succeed())
+			})
+
+			It("should fail to verify the stored file against a different checksum", func() {
+				Expect(cacheEntry.Verify("other", sha256.New())).To(MatchError(fmt.Sprintf("Cached file '%s' checksum does not match supplied value 'other'", downloadFilePath)))
+			})
+
 			Context("when the download content cannot be read", func() {
 				BeforeEach(func() {
 					downloadContent = ioutil.NopCloser(badReader{})
@@ -346,6 +373,7 @@ var _ = Describe("CacheEntry", func() {
 				if cacheEntry, ok := cacheEntry.(cache.FieldSetter); ok {
 					cacheEntry.SetChecksumCalculator(fakeChecksumCalculator)
 					cacheEntry.SetEtagHelper(fakeEtagHelper)
+					cacheEntry.SetChecksumHelper(fakeChecksumHelper)
 				} else {
 					Fail("cache entry did not implement FieldSetter")
 				}
@@ -421,6 +449,24 @@ var _ = Describe("CacheEntry", func() {
 						Expect(fakeEtagHelper.SetEtagForUrlCallCount()).To(Equal(0))
 					})
 				})
+
+				It("should record the checksum", func() {
+					Expect(fakeChecksumHelper.SetChecksumForUrlCallCount()).To(Equal(1))
+
+					urlArg, checksumArg := fakeChecksumHelper.SetChecksumForUrlArgsForCall(0)
+					Expect(urlArg).To(Equal(urlValue))
+					Expect(checksumArg).To(Equal(checksumValue))
+				})
+
+				Context("when trying to record the checksum fails with an error", func() {
+					BeforeEach(func() {
+						fakeChecksumHelper.SetChecksumForUrlReturns(testError)
+					})
+
+					It("should propagate the error", func() {
+						Expect(err).To(MatchError(testError))
+					})
+				})
 			})
 
 			Context("when it is not possible to create the download file", func() {
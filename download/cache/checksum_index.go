@@ -0,0 +1,40 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cache
+
+// checksumIndex records the verified checksum of each cached file. It shares the file format of etagIndex.
+type checksumIndex etagIndex
+
+func NewChecksumIndex(indexFile string) (*checksumIndex, error) {
+	h, err := NewEtagIndex(indexFile)
+	if err != nil {
+		return nil, err
+	}
+	return (*checksumIndex)(h), nil
+}
+
+func (h *checksumIndex) GetChecksumForUrl(url string) (string, error) {
+	return (*etagIndex)(h).GetETagForUrl(url)
+}
+
+func (h *checksumIndex) SetChecksumForUrl(url string, checksum string) error {
+	return (*etagIndex)(h).SetEtagForUrl(url, checksum)
+}
+
+func (h *checksumIndex) Urls() ([]string, error) {
+	return (*etagIndex)(h).Urls()
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download/cache"
+)
+
+var _ = Describe("ChecksumIndex", func() {
+	const (
+		url1      = "http://url.1"
+		url2      = "http://url.2"
+		checksum1 = "checksum1"
+		checksum2 = "checksum2"
+	)
+	var (
+		indexDir      string
+		checksumIndex cache.ChecksumHelper
+	)
+
+	BeforeEach(func() {
+		var err error
+		indexDir, err = ioutil.TempDir("", "checksum_index_test")
+		Expect(err).NotTo(HaveOccurred())
+		checksumIndex, err = cache.NewChecksumIndex(path.Join(indexDir, "indexFile"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(indexDir)).To(Succeed())
+	})
+
+	It("should record a checksum for a given URL", func() {
+		Expect(checksumIndex.SetChecksumForUrl(url1, checksum1)).To(Succeed())
+
+		c, err := checksumIndex.GetChecksumForUrl(url1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(checksum1))
+	})
+
+	It("should cope with an unknown URL", func() {
+		c, err := checksumIndex.GetChecksumForUrl(url1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(""))
+	})
+
+	It("should list the URLs with checksums", func() {
+		Expect(checksumIndex.SetChecksumForUrl(url2, checksum2)).To(Succeed())
+		Expect(checksumIndex.SetChecksumForUrl(url1, checksum1)).To(Succeed())
+
+		urls, err := checksumIndex.Urls()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(urls).To(Equal([]string{url1, url2}))
+	})
+
+	Context("when the underlying file turns out to be a directory", func() {
+		It("should return an error from NewChecksumIndex", func() {
+			indexFile := path.Join(indexDir, "dir")
+			Expect(os.MkdirAll(indexFile, 0755)).To(Succeed())
+
+			_, err := cache.NewChecksumIndex(indexFile)
+			Expect(err).To(BeAssignableToTypeOf(&os.PathError{}))
+		})
+	})
+})
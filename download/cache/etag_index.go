@@ -19,6 +19,7 @@ package cache
 import (
 	"encoding/json"
 	"io/ioutil"
+	"sort"
 )
 
 type etagIndex struct {
@@ -63,6 +64,21 @@ func (h *etagIndex) SetEtagForUrl(url string, etag string) error {
 	return h.writeIndex(index)
 }
 
+func (h *etagIndex) Urls() ([]string, error) {
+	index := IndexMap{}
+	err := h.readIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := []string{}
+	for url := range index {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+	return urls, nil
+}
+
 func (h *etagIndex) writeIndex(index IndexMap) error {
 	bytes, err := json.Marshal(index)
 	if err != nil {
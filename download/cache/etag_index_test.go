@@ -78,6 +78,15 @@ var _ = Describe("EtagIndex", func() {
 		Expect(e).To(Equal(etag2))
 	})
 
+	It("should list the URLs with etags", func() {
+		Expect(etagIndex.SetEtagForUrl(url2, etag2)).To(Succeed())
+		Expect(etagIndex.SetEtagForUrl(url1, etag1)).To(Succeed())
+
+		urls, err := etagIndex.Urls()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(urls).To(Equal([]string{url1, url2}))
+	})
+
 	Context("when the underlying file is deleted", func() {
 		BeforeEach(func() {
 			Expect(os.Remove(indexFile)).To(Succeed())
@@ -19,6 +19,7 @@ package cache
 type FieldGetter interface {
 	GetChecksumCalculator() ChecksumCalculator
 	GetEtagHelper() EtagHelper
+	GetChecksumHelper() ChecksumHelper
 	GetDownloadUrl() string
 	GetDownloadFile() string
 }
@@ -31,6 +32,10 @@ func (f *fileCacheEntry) GetEtagHelper() EtagHelper {
 	return f.etagHelper
 }
 
+func (f *fileCacheEntry) GetChecksumHelper() ChecksumHelper {
+	return f.checksumHelper
+}
+
 func (f *fileCacheEntry) GetDownloadUrl() string {
 	return f.downloadUrl
 }
@@ -42,6 +47,7 @@ func (f *fileCacheEntry) GetDownloadFile() string {
 type FieldSetter interface {
 	SetChecksumCalculator(calculator ChecksumCalculator)
 	SetEtagHelper(helper EtagHelper)
+	SetChecksumHelper(helper ChecksumHelper)
 	SetDownloadFile(filePath string)
 }
 
@@ -53,6 +59,10 @@ func (f *fileCacheEntry) SetEtagHelper(helper EtagHelper) {
 	f.etagHelper = helper
 }
 
+func (f *fileCacheEntry) SetChecksumHelper(helper ChecksumHelper) {
+	f.checksumHelper = helper
+}
+
 func (f *fileCacheEntry) SetDownloadFile(filePath string) {
 	f.downloadFile = filePath
 }
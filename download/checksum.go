@@ -0,0 +1,41 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package download
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+)
+
+// ChecksumHashFunc returns a hash function suitable for verifying the given hex encoded checksum. SHA-256 and SHA-1
+// checksums are supported and are distinguished by their length.
+func ChecksumHashFunc(checksum string) (hash.Hash, error) {
+	if _, err := hex.DecodeString(checksum); err != nil {
+		return nil, fmt.Errorf("Checksum '%s' is not a hexadecimal string", checksum)
+	}
+
+	switch len(checksum) {
+	case sha256.Size * 2:
+		return sha256.New(), nil
+	case sha1.Size * 2:
+		return sha1.New(), nil
+	}
+	return nil, fmt.Errorf("Checksum '%s' is neither a SHA-256 nor a SHA-1 checksum", checksum)
+}
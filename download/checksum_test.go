@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package download_test
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"hash"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download"
+)
+
+var _ = Describe("ChecksumHashFunc", func() {
+	var (
+		checksum string
+		hashFunc hash.Hash
+		err      error
+	)
+
+	JustBeforeEach(func() {
+		hashFunc, err = download.ChecksumHashFunc(checksum)
+	})
+
+	Context("when the checksum is a SHA-256 checksum", func() {
+		BeforeEach(func() {
+			checksum = strings.Repeat("ab", sha256.Size)
+		})
+
+		It("should return a SHA-256 hash function", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashFunc.Size()).To(Equal(sha256.Size))
+		})
+	})
+
+	Context("when the checksum is a SHA-1 checksum", func() {
+		BeforeEach(func() {
+			checksum = strings.Repeat("CD", sha1.Size)
+		})
+
+		It("should return a SHA-1 hash function", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hashFunc.Size()).To(Equal(sha1.Size))
+		})
+	})
+
+	Context("when the checksum has an unsupported length", func() {
+		BeforeEach(func() {
+			checksum = "abcd"
+		})
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Checksum 'abcd' is neither a SHA-256 nor a SHA-1 checksum"))
+		})
+	})
+
+	Context("when the checksum is not hexadecimal", func() {
+		BeforeEach(func() {
+			checksum = strings.Repeat("xy", sha256.Size)
+		})
+
+		It("should return a suitable error", func() {
+			Expect(err).To(MatchError("Checksum '" + checksum + "' is not a hexadecimal string"))
+		})
+	})
+})
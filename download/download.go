@@ -17,6 +17,7 @@
 package download
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -24,6 +25,7 @@ import (
 	"hash"
 
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download/cache"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
 )
 
 const (
@@ -106,16 +108,21 @@ type HttpHelper interface {
 }
 
 type httpHelper struct {
+	ctx         context.Context
+	traceWriter io.Writer
 }
 
 func (h *httpHelper) CreateHttpRequest(method string, url string) (HttpRequest, error) {
-	tr := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	var tr http.RoundTripper = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if h.traceWriter != nil {
+		tr = httpclient.NewTracingTransport(tr, h.traceWriter)
+	}
 	cl := &http.Client{
 		Transport:     tr,
 		CheckRedirect: nil,
 	}
 
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequestWithContext(h.ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +134,18 @@ func (h *httpHelper) CreateHttpRequest(method string, url string) (HttpRequest,
 }
 
 func NewHttpHelper() *httpHelper {
-	return &httpHelper{}
+	return NewContextHttpHelper(context.Background())
+}
+
+// NewContextHttpHelper returns a HttpHelper which creates requests that are cancelled when the given context is done.
+func NewContextHttpHelper(ctx context.Context) *httpHelper {
+	return NewTracingHttpHelper(ctx, nil)
+}
+
+// NewTracingHttpHelper is like NewContextHttpHelper but, unless the given trace writer is nil, requests and responses
+// are traced to the trace writer.
+func NewTracingHttpHelper(ctx context.Context, traceWriter io.Writer) *httpHelper {
+	return &httpHelper{ctx: ctx, traceWriter: traceWriter}
 }
 
 type Downloader interface {
@@ -171,6 +189,10 @@ func (d *downloader) DownloadFile(url string, checksum string, hashFunc hash.Has
 
 	response, err := getRequest.SendRequest()
 	if err != nil {
+		if downloadedFilePath != "" && cacheEntry.Verify(checksum, hashFunc) == nil {
+			fmt.Fprintf(d.progressWriter, "Download from URL %q failed: %s. Using cached file.\n", url, err)
+			return downloadedFilePath, nil
+		}
 		return "", fmt.Errorf("Download from URL %q failed: %s", url, err)
 	}
 
@@ -17,12 +17,15 @@
 package download_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"errors"
 
 	"net/http"
+	"net/http/httptest"
 
 	"fmt"
 
@@ -192,6 +195,38 @@ var _ = Describe("Download", func() {
 					It("should propagate the error", func() {
 						Expect(err).To(MatchError(fmt.Sprintf(`Download from URL "http://some/remote/file" failed: %s`, errMessage)))
 					})
+
+					It("should not try to verify a cached file", func() {
+						Expect(fakeCacheEntry.VerifyCallCount()).To(Equal(0))
+					})
+
+					Context("when the file has previously been cached", func() {
+						BeforeEach(func() {
+							fakeCacheEntry.RetrieveReturns(testFilePath, etag, nil)
+						})
+
+						It("should verify the cached file against the supplied checksum", func() {
+							Expect(fakeCacheEntry.VerifyCallCount()).To(Equal(1))
+							checksum, hash := fakeCacheEntry.VerifyArgsForCall(0)
+							Expect(checksum).To(Equal(checksumValue))
+							Expect(hash).To(Equal(hashFunc))
+						})
+
+						It("should return the path of the cached file", func() {
+							Expect(err).NotTo(HaveOccurred())
+							Expect(filePath).To(Equal(testFilePath))
+						})
+
+						Context("when the cached file fails verification", func() {
+							BeforeEach(func() {
+								fakeCacheEntry.VerifyReturns(errors.New("checksum mismatch"))
+							})
+
+							It("should propagate the download error", func() {
+								Expect(err).To(MatchError(fmt.Sprintf(`Download from URL "http://some/remote/file" failed: %s`, errMessage)))
+							})
+						})
+					})
 				})
 
 				Context("when sending the HTTP GET request is successful and returns a 304 response code", func() {
@@ -311,6 +346,42 @@ var _ = Describe("Download", func() {
 	})
 })
 
+var _ = Describe("HttpHelper", func() {
+	It("should create requests with the supplied context", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		request, err := download.NewContextHttpHelper(ctx).CreateHttpRequest(http.MethodGet, urlValue)
+		Expect(err).NotTo(HaveOccurred())
+
+		if request, ok := request.(download.RequestFieldGetter); ok {
+			Expect(request.GetContext()).To(Equal(ctx))
+		} else {
+			Fail("request did not implement RequestFieldGetter")
+		}
+	})
+
+	It("should trace requests to the supplied trace writer", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/java-archive")
+			w.Write([]byte("jar contents"))
+		}))
+		defer server.Close()
+
+		trace := &bytes.Buffer{}
+		request, err := download.NewTracingHttpHelper(context.Background(), trace).CreateHttpRequest(http.MethodGet, server.URL+"/shell.jar")
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err := request.SendRequest()
+		Expect(err).NotTo(HaveOccurred())
+		defer response.GetBody().Close()
+
+		Expect(trace.String()).To(ContainSubstring("GET " + server.URL + "/shell.jar HTTP/1.1"))
+		Expect(trace.String()).To(ContainSubstring("[application/java-archive body of 12 bytes not shown]"))
+		Expect(ioutil.ReadAll(response.GetBody())).To(Equal([]byte("jar contents")))
+	})
+})
+
 var _ = Describe("HttpRequest", func() {
 	var (
 		testError error
@@ -10,10 +10,11 @@ import (
 )
 
 type FakeCacheEntry struct {
-	RetrieveStub        func() (path string, etag string, err error)
+	RetrieveStub        func() (string, string, error)
 	retrieveMutex       sync.RWMutex
-	retrieveArgsForCall []struct{}
-	retrieveReturns     struct {
+	retrieveArgsForCall []struct {
+	}
+	retrieveReturns struct {
 		result1 string
 		result2 string
 		result3 error
@@ -23,13 +24,13 @@ type FakeCacheEntry struct {
 		result2 string
 		result3 error
 	}
-	StoreStub        func(contents io.ReadCloser, etag string, checksum string, hashFunc hash.Hash) error
+	StoreStub        func(io.ReadCloser, string, string, hash.Hash) error
 	storeMutex       sync.RWMutex
 	storeArgsForCall []struct {
-		contents io.ReadCloser
-		etag     string
-		checksum string
-		hashFunc hash.Hash
+		arg1 io.ReadCloser
+		arg2 string
+		arg3 string
+		arg4 hash.Hash
 	}
 	storeReturns struct {
 		result1 error
@@ -37,23 +38,38 @@ type FakeCacheEntry struct {
 	storeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VerifyStub        func(string, hash.Hash) error
+	verifyMutex       sync.RWMutex
+	verifyArgsForCall []struct {
+		arg1 string
+		arg2 hash.Hash
+	}
+	verifyReturns struct {
+		result1 error
+	}
+	verifyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCacheEntry) Retrieve() (path string, etag string, err error) {
+func (fake *FakeCacheEntry) Retrieve() (string, string, error) {
 	fake.retrieveMutex.Lock()
 	ret, specificReturn := fake.retrieveReturnsOnCall[len(fake.retrieveArgsForCall)]
-	fake.retrieveArgsForCall = append(fake.retrieveArgsForCall, struct{}{})
+	fake.retrieveArgsForCall = append(fake.retrieveArgsForCall, struct {
+	}{})
+	stub := fake.RetrieveStub
+	fakeReturns := fake.retrieveReturns
 	fake.recordInvocation("Retrieve", []interface{}{})
 	fake.retrieveMutex.Unlock()
-	if fake.RetrieveStub != nil {
-		return fake.RetrieveStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.retrieveReturns.result1, fake.retrieveReturns.result2, fake.retrieveReturns.result3
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeCacheEntry) RetrieveCallCount() int {
@@ -62,7 +78,15 @@ func (fake *FakeCacheEntry) RetrieveCallCount() int {
 	return len(fake.retrieveArgsForCall)
 }
 
+func (fake *FakeCacheEntry) RetrieveCalls(stub func() (string, string, error)) {
+	fake.retrieveMutex.Lock()
+	defer fake.retrieveMutex.Unlock()
+	fake.RetrieveStub = stub
+}
+
 func (fake *FakeCacheEntry) RetrieveReturns(result1 string, result2 string, result3 error) {
+	fake.retrieveMutex.Lock()
+	defer fake.retrieveMutex.Unlock()
 	fake.RetrieveStub = nil
 	fake.retrieveReturns = struct {
 		result1 string
@@ -72,6 +96,8 @@ func (fake *FakeCacheEntry) RetrieveReturns(result1 string, result2 string, resu
 }
 
 func (fake *FakeCacheEntry) RetrieveReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.retrieveMutex.Lock()
+	defer fake.retrieveMutex.Unlock()
 	fake.RetrieveStub = nil
 	if fake.retrieveReturnsOnCall == nil {
 		fake.retrieveReturnsOnCall = make(map[int]struct {
@@ -87,24 +113,26 @@ func (fake *FakeCacheEntry) RetrieveReturnsOnCall(i int, result1 string, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCacheEntry) Store(contents io.ReadCloser, etag string, checksum string, hashFunc hash.Hash) error {
+func (fake *FakeCacheEntry) Store(arg1 io.ReadCloser, arg2 string, arg3 string, arg4 hash.Hash) error {
 	fake.storeMutex.Lock()
 	ret, specificReturn := fake.storeReturnsOnCall[len(fake.storeArgsForCall)]
 	fake.storeArgsForCall = append(fake.storeArgsForCall, struct {
-		contents io.ReadCloser
-		etag     string
-		checksum string
-		hashFunc hash.Hash
-	}{contents, etag, checksum, hashFunc})
-	fake.recordInvocation("Store", []interface{}{contents, etag, checksum, hashFunc})
+		arg1 io.ReadCloser
+		arg2 string
+		arg3 string
+		arg4 hash.Hash
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StoreStub
+	fakeReturns := fake.storeReturns
+	fake.recordInvocation("Store", []interface{}{arg1, arg2, arg3, arg4})
 	fake.storeMutex.Unlock()
-	if fake.StoreStub != nil {
-		return fake.StoreStub(contents, etag, checksum, hashFunc)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	return fake.storeReturns.result1
+	return fakeReturns.result1
 }
 
 func (fake *FakeCacheEntry) StoreCallCount() int {
@@ -113,13 +141,22 @@ func (fake *FakeCacheEntry) StoreCallCount() int {
 	return len(fake.storeArgsForCall)
 }
 
+func (fake *FakeCacheEntry) StoreCalls(stub func(io.ReadCloser, string, string, hash.Hash) error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
+	fake.StoreStub = stub
+}
+
 func (fake *FakeCacheEntry) StoreArgsForCall(i int) (io.ReadCloser, string, string, hash.Hash) {
 	fake.storeMutex.RLock()
 	defer fake.storeMutex.RUnlock()
-	return fake.storeArgsForCall[i].contents, fake.storeArgsForCall[i].etag, fake.storeArgsForCall[i].checksum, fake.storeArgsForCall[i].hashFunc
+	argsForCall := fake.storeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeCacheEntry) StoreReturns(result1 error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
 	fake.StoreStub = nil
 	fake.storeReturns = struct {
 		result1 error
@@ -127,6 +164,8 @@ func (fake *FakeCacheEntry) StoreReturns(result1 error) {
 }
 
 func (fake *FakeCacheEntry) StoreReturnsOnCall(i int, result1 error) {
+	fake.storeMutex.Lock()
+	defer fake.storeMutex.Unlock()
 	fake.StoreStub = nil
 	if fake.storeReturnsOnCall == nil {
 		fake.storeReturnsOnCall = make(map[int]struct {
@@ -138,6 +177,68 @@ func (fake *FakeCacheEntry) StoreReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCacheEntry) Verify(arg1 string, arg2 hash.Hash) error {
+	fake.verifyMutex.Lock()
+	ret, specificReturn := fake.verifyReturnsOnCall[len(fake.verifyArgsForCall)]
+	fake.verifyArgsForCall = append(fake.verifyArgsForCall, struct {
+		arg1 string
+		arg2 hash.Hash
+	}{arg1, arg2})
+	stub := fake.VerifyStub
+	fakeReturns := fake.verifyReturns
+	fake.recordInvocation("Verify", []interface{}{arg1, arg2})
+	fake.verifyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCacheEntry) VerifyCallCount() int {
+	fake.verifyMutex.RLock()
+	defer fake.verifyMutex.RUnlock()
+	return len(fake.verifyArgsForCall)
+}
+
+func (fake *FakeCacheEntry) VerifyCalls(stub func(string, hash.Hash) error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = stub
+}
+
+func (fake *FakeCacheEntry) VerifyArgsForCall(i int) (string, hash.Hash) {
+	fake.verifyMutex.RLock()
+	defer fake.verifyMutex.RUnlock()
+	argsForCall := fake.verifyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCacheEntry) VerifyReturns(result1 error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = nil
+	fake.verifyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheEntry) VerifyReturnsOnCall(i int, result1 error) {
+	fake.verifyMutex.Lock()
+	defer fake.verifyMutex.Unlock()
+	fake.VerifyStub = nil
+	if fake.verifyReturnsOnCall == nil {
+		fake.verifyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCacheEntry) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -145,6 +246,8 @@ func (fake *FakeCacheEntry) Invocations() map[string][][]interface{} {
 	defer fake.retrieveMutex.RUnlock()
 	fake.storeMutex.RLock()
 	defer fake.storeMutex.RUnlock()
+	fake.verifyMutex.RLock()
+	defer fake.verifyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -8,10 +8,10 @@ import (
 )
 
 type FakeEtagHelper struct {
-	GetETagForUrlStub        func(url string) (string, error)
+	GetETagForUrlStub        func(string) (string, error)
 	getETagForUrlMutex       sync.RWMutex
 	getETagForUrlArgsForCall []struct {
-		url string
+		arg1 string
 	}
 	getETagForUrlReturns struct {
 		result1 string
@@ -21,11 +21,11 @@ type FakeEtagHelper struct {
 		result1 string
 		result2 error
 	}
-	SetEtagForUrlStub        func(url string, etag string) error
+	SetEtagForUrlStub        func(string, string) error
 	setEtagForUrlMutex       sync.RWMutex
 	setEtagForUrlArgsForCall []struct {
-		url  string
-		etag string
+		arg1 string
+		arg2 string
 	}
 	setEtagForUrlReturns struct {
 		result1 error
@@ -33,25 +33,39 @@ type FakeEtagHelper struct {
 	setEtagForUrlReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UrlsStub        func() ([]string, error)
+	urlsMutex       sync.RWMutex
+	urlsArgsForCall []struct {
+	}
+	urlsReturns struct {
+		result1 []string
+		result2 error
+	}
+	urlsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeEtagHelper) GetETagForUrl(url string) (string, error) {
+func (fake *FakeEtagHelper) GetETagForUrl(arg1 string) (string, error) {
 	fake.getETagForUrlMutex.Lock()
 	ret, specificReturn := fake.getETagForUrlReturnsOnCall[len(fake.getETagForUrlArgsForCall)]
 	fake.getETagForUrlArgsForCall = append(fake.getETagForUrlArgsForCall, struct {
-		url string
-	}{url})
-	fake.recordInvocation("GetETagForUrl", []interface{}{url})
+		arg1 string
+	}{arg1})
+	stub := fake.GetETagForUrlStub
+	fakeReturns := fake.getETagForUrlReturns
+	fake.recordInvocation("GetETagForUrl", []interface{}{arg1})
 	fake.getETagForUrlMutex.Unlock()
-	if fake.GetETagForUrlStub != nil {
-		return fake.GetETagForUrlStub(url)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.getETagForUrlReturns.result1, fake.getETagForUrlReturns.result2
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeEtagHelper) GetETagForUrlCallCount() int {
@@ -60,13 +74,22 @@ func (fake *FakeEtagHelper) GetETagForUrlCallCount() int {
 	return len(fake.getETagForUrlArgsForCall)
 }
 
+func (fake *FakeEtagHelper) GetETagForUrlCalls(stub func(string) (string, error)) {
+	fake.getETagForUrlMutex.Lock()
+	defer fake.getETagForUrlMutex.Unlock()
+	fake.GetETagForUrlStub = stub
+}
+
 func (fake *FakeEtagHelper) GetETagForUrlArgsForCall(i int) string {
 	fake.getETagForUrlMutex.RLock()
 	defer fake.getETagForUrlMutex.RUnlock()
-	return fake.getETagForUrlArgsForCall[i].url
+	argsForCall := fake.getETagForUrlArgsForCall[i]
+	return argsForCall.arg1
 }
 
 func (fake *FakeEtagHelper) GetETagForUrlReturns(result1 string, result2 error) {
+	fake.getETagForUrlMutex.Lock()
+	defer fake.getETagForUrlMutex.Unlock()
 	fake.GetETagForUrlStub = nil
 	fake.getETagForUrlReturns = struct {
 		result1 string
@@ -75,6 +98,8 @@ func (fake *FakeEtagHelper) GetETagForUrlReturns(result1 string, result2 error)
 }
 
 func (fake *FakeEtagHelper) GetETagForUrlReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getETagForUrlMutex.Lock()
+	defer fake.getETagForUrlMutex.Unlock()
 	fake.GetETagForUrlStub = nil
 	if fake.getETagForUrlReturnsOnCall == nil {
 		fake.getETagForUrlReturnsOnCall = make(map[int]struct {
@@ -88,22 +113,24 @@ func (fake *FakeEtagHelper) GetETagForUrlReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
-func (fake *FakeEtagHelper) SetEtagForUrl(url string, etag string) error {
+func (fake *FakeEtagHelper) SetEtagForUrl(arg1 string, arg2 string) error {
 	fake.setEtagForUrlMutex.Lock()
 	ret, specificReturn := fake.setEtagForUrlReturnsOnCall[len(fake.setEtagForUrlArgsForCall)]
 	fake.setEtagForUrlArgsForCall = append(fake.setEtagForUrlArgsForCall, struct {
-		url  string
-		etag string
-	}{url, etag})
-	fake.recordInvocation("SetEtagForUrl", []interface{}{url, etag})
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetEtagForUrlStub
+	fakeReturns := fake.setEtagForUrlReturns
+	fake.recordInvocation("SetEtagForUrl", []interface{}{arg1, arg2})
 	fake.setEtagForUrlMutex.Unlock()
-	if fake.SetEtagForUrlStub != nil {
-		return fake.SetEtagForUrlStub(url, etag)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	return fake.setEtagForUrlReturns.result1
+	return fakeReturns.result1
 }
 
 func (fake *FakeEtagHelper) SetEtagForUrlCallCount() int {
@@ -112,13 +139,22 @@ func (fake *FakeEtagHelper) SetEtagForUrlCallCount() int {
 	return len(fake.setEtagForUrlArgsForCall)
 }
 
+func (fake *FakeEtagHelper) SetEtagForUrlCalls(stub func(string, string) error) {
+	fake.setEtagForUrlMutex.Lock()
+	defer fake.setEtagForUrlMutex.Unlock()
+	fake.SetEtagForUrlStub = stub
+}
+
 func (fake *FakeEtagHelper) SetEtagForUrlArgsForCall(i int) (string, string) {
 	fake.setEtagForUrlMutex.RLock()
 	defer fake.setEtagForUrlMutex.RUnlock()
-	return fake.setEtagForUrlArgsForCall[i].url, fake.setEtagForUrlArgsForCall[i].etag
+	argsForCall := fake.setEtagForUrlArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeEtagHelper) SetEtagForUrlReturns(result1 error) {
+	fake.setEtagForUrlMutex.Lock()
+	defer fake.setEtagForUrlMutex.Unlock()
 	fake.SetEtagForUrlStub = nil
 	fake.setEtagForUrlReturns = struct {
 		result1 error
@@ -126,6 +162,8 @@ func (fake *FakeEtagHelper) SetEtagForUrlReturns(result1 error) {
 }
 
 func (fake *FakeEtagHelper) SetEtagForUrlReturnsOnCall(i int, result1 error) {
+	fake.setEtagForUrlMutex.Lock()
+	defer fake.setEtagForUrlMutex.Unlock()
 	fake.SetEtagForUrlStub = nil
 	if fake.setEtagForUrlReturnsOnCall == nil {
 		fake.setEtagForUrlReturnsOnCall = make(map[int]struct {
@@ -137,6 +175,62 @@ func (fake *FakeEtagHelper) SetEtagForUrlReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeEtagHelper) Urls() ([]string, error) {
+	fake.urlsMutex.Lock()
+	ret, specificReturn := fake.urlsReturnsOnCall[len(fake.urlsArgsForCall)]
+	fake.urlsArgsForCall = append(fake.urlsArgsForCall, struct {
+	}{})
+	stub := fake.UrlsStub
+	fakeReturns := fake.urlsReturns
+	fake.recordInvocation("Urls", []interface{}{})
+	fake.urlsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEtagHelper) UrlsCallCount() int {
+	fake.urlsMutex.RLock()
+	defer fake.urlsMutex.RUnlock()
+	return len(fake.urlsArgsForCall)
+}
+
+func (fake *FakeEtagHelper) UrlsCalls(stub func() ([]string, error)) {
+	fake.urlsMutex.Lock()
+	defer fake.urlsMutex.Unlock()
+	fake.UrlsStub = stub
+}
+
+func (fake *FakeEtagHelper) UrlsReturns(result1 []string, result2 error) {
+	fake.urlsMutex.Lock()
+	defer fake.urlsMutex.Unlock()
+	fake.UrlsStub = nil
+	fake.urlsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEtagHelper) UrlsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.urlsMutex.Lock()
+	defer fake.urlsMutex.Unlock()
+	fake.UrlsStub = nil
+	if fake.urlsReturnsOnCall == nil {
+		fake.urlsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.urlsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeEtagHelper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -144,6 +238,8 @@ func (fake *FakeEtagHelper) Invocations() map[string][][]interface{} {
 	defer fake.getETagForUrlMutex.RUnlock()
 	fake.setEtagForUrlMutex.RLock()
 	defer fake.setEtagForUrlMutex.RUnlock()
+	fake.urlsMutex.RLock()
+	defer fake.urlsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
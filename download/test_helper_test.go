@@ -16,16 +16,24 @@
  */
 package download
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 type RequestFieldGetter interface {
 	GetHeaderMap() http.Header
+	GetContext() context.Context
 }
 
 func (h *httpRequest) GetHeaderMap() http.Header {
 	return h.request.Header
 }
 
+func (h *httpRequest) GetContext() context.Context {
+	return h.request.Context()
+}
+
 type HttpClientSetter interface {
 	SetHttpClient(client HttpClient)
 }
@@ -17,12 +17,14 @@
 package format
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 
 	"code.cloudfoundry.org/cli/plugin"
 	"github.com/fatih/color"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
 )
 
 var (
@@ -40,7 +42,7 @@ func RunAction(cliConnection plugin.CliConnection, message string, action Action
 	printStartAction(cliConnection, message, writer)
 	output, err := action(writer)
 	if err != nil {
-		Diagnose(err.Error(), writer, onFailure)
+		DiagnoseError(err, writer, onFailure)
 		return
 	}
 	fmt.Fprintf(writer, "%s\n\n%s", Bold(Green("OK")), output)
@@ -50,7 +52,7 @@ func RunAction(cliConnection plugin.CliConnection, message string, action Action
 func RunActionQuietly(cliConnection plugin.CliConnection, action func() (string, error), writer io.Writer, onFailure func()) {
 	output, err := action()
 	if err != nil {
-		Diagnose(err.Error(), writer, onFailure)
+		DiagnoseError(err, writer, onFailure)
 		return
 	}
 	fmt.Fprintln(writer, output)
@@ -76,6 +78,29 @@ func printStartAction(cliConnection plugin.CliConnection, message string, writer
 }
 
 func Diagnose(message string, writer io.Writer, onFailure func()) {
+	diagnose(message, "", writer, onFailure)
+}
+
+// DiagnoseError is like Diagnose but, if the error was reported by a dataflow or Skipper server, the server's error
+// message and log references are printed.
+func DiagnoseError(err error, writer io.Writer, onFailure func()) {
+	var serverError *httpclient.ServerError
+	if !errors.As(err, &serverError) || len(serverError.Errors) == 0 {
+		Diagnose(err.Error(), writer, onFailure)
+		return
+	}
+
+	details := ""
+	if message := serverError.Message(); message != "" {
+		details = fmt.Sprintf("Server message: %s\n", message)
+	}
+	if logrefs := serverError.Logrefs(); len(logrefs) > 0 {
+		details += fmt.Sprintf("Server log reference: %s\n", strings.Join(logrefs, ", "))
+	}
+	diagnose(err.Error(), details, writer, onFailure)
+}
+
+func diagnose(message string, details string, writer io.Writer, onFailure func()) {
 	fmt.Fprintf(writer, "%s\n", Bold(Red("FAILED")))
 
 	hint := ""
@@ -83,6 +108,6 @@ func Diagnose(message string, writer io.Writer, onFailure func()) {
 		hint = "\nTIP: Use 'cf api --skip-ssl-validation' to continue with an insecure API endpoint\n"
 	}
 
-	fmt.Fprintf(writer, "%s\n%s", message, hint)
+	fmt.Fprintf(writer, "%s\n%s%s", message, details, hint)
 	onFailure()
 }
@@ -18,6 +18,7 @@ package format_test
 
 import (
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
 
 	"bytes"
 	"errors"
@@ -179,6 +180,23 @@ var _ = Describe("Actions", func() {
 				Expect(output).To(ContainSubstring(certHint))
 			})
 		})
+
+		Context("when the action fails with an error reported by a server", func() {
+			BeforeEach(func() {
+				action = func(progressWriter io.Writer) (string, error) {
+					return "", fmt.Errorf("Dataflow server error: %w", &httpclient.ServerError{
+						StatusCode: 409,
+						Status:     "409 Conflict",
+						Errors:     []httpclient.VndError{{Logref: "DuplicateStreamDefinitionException", Message: "Stream ticktock already exists"}},
+					})
+				}
+			})
+
+			It("should print the server's message and log reference", func() {
+				Expect(output).To(HaveSuffix(fmt.Sprintf("%s\nDataflow server error: 409 Conflict\nServer message: Stream ticktock already exists\nServer log reference: DuplicateStreamDefinitionException\n",
+					format.Bold(format.Red(failMessage)))))
+			})
+		})
 	})
 
 	Describe("RunActionQuietly", func() {
@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Field is a named value displayed by Fields.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Fields formats the given fields one per line, in the style of the cf CLI, with the values aligned. A multi-line value
+// is indented to align with the first line.
+func Fields(fields ...Field) string {
+	width := 0
+	for _, field := range fields {
+		if n := utf8.RuneCountInString(field.Name); n > width {
+			width = n
+		}
+	}
+
+	var formatted strings.Builder
+	for _, field := range fields {
+		label := field.Name + ":" + strings.Repeat(" ", width-utf8.RuneCountInString(field.Name)+len(columnSeparator))
+		value := strings.Replace(field.Value, "\n", "\n"+strings.Repeat(" ", utf8.RuneCountInString(label)), -1)
+		formatted.WriteString(strings.TrimRight(label+value, " ") + "\n")
+	}
+	return formatted.String()
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package format_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+var _ = Describe("Fields", func() {
+	It("should align values", func() {
+		Expect(format.Fields(
+			format.Field{Name: "name", Value: "ticktock"},
+			format.Field{Name: "definition", Value: "time | log"},
+		)).To(Equal("name:         ticktock\n" +
+			"definition:   time | log\n"))
+	})
+
+	It("should indent multi-line values", func() {
+		Expect(format.Fields(format.Field{Name: "log", Value: "line 1\nline 2"})).To(Equal("log:   line 1\n" +
+			"       line 2\n"))
+	})
+
+	It("should not pad empty values", func() {
+		Expect(format.Fields(format.Field{Name: "description"})).To(Equal("description:\n"))
+	})
+})
@@ -0,0 +1,58 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const columnSeparator = "   "
+
+// Table formats the given rows in columns, in the style of the cf CLI, beneath the given headings, which are
+// emboldened. Cells must not contain colour or other escape sequences since these would upset the alignment.
+func Table(headings []string, rows [][]string) string {
+	widths := make([]int, len(headings))
+	for _, row := range append([][]string{headings}, rows...) {
+		for i, cell := range row {
+			if i < len(widths) && utf8.RuneCountInString(cell) > widths[i] {
+				widths[i] = utf8.RuneCountInString(cell)
+			}
+		}
+	}
+
+	var table strings.Builder
+	table.WriteString(formatRow(headings, widths, Bold))
+	for _, row := range rows {
+		table.WriteString(formatRow(row, widths, nil))
+	}
+	return table.String()
+}
+
+func formatRow(row []string, widths []int, style func(format string, a ...interface{}) string) string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		if i < len(row)-1 && i < len(widths) {
+			cell += strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+		}
+		if style != nil {
+			cell = style("%s", cell)
+		}
+		cells[i] = cell
+	}
+	return strings.TrimRight(strings.Join(cells, columnSeparator), " ") + "\n"
+}
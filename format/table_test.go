@@ -0,0 +1,64 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package format_test
+
+import (
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/format"
+)
+
+var _ = Describe("Table", func() {
+	BeforeEach(func() {
+		color.NoColor = true
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	It("should align columns", func() {
+		Expect(format.Table([]string{"name", "status"}, [][]string{
+			{"ticktock", "deployed"},
+			{"a", "undeployed"},
+		})).To(Equal("name       status\n" +
+			"ticktock   deployed\n" +
+			"a          undeployed\n"))
+	})
+
+	It("should not pad empty trailing cells", func() {
+		Expect(format.Table([]string{"name", "description"}, [][]string{
+			{"ticktock", ""},
+		})).To(Equal("name       description\n" +
+			"ticktock\n"))
+	})
+
+	It("should format headings only when there are no rows", func() {
+		Expect(format.Table([]string{"name", "status"}, nil)).To(Equal("name   status\n"))
+	})
+
+	Context("when colour is enabled", func() {
+		BeforeEach(func() {
+			color.NoColor = false
+		})
+
+		It("should embolden the headings", func() {
+			Expect(format.Table([]string{"name"}, [][]string{{"a"}})).To(Equal(format.Bold("name") + "\na\n"))
+		})
+	})
+})
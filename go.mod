@@ -13,5 +13,5 @@ require (
 	golang.org/x/net v0.0.0-20171107184841-a337091b0525 // indirect
 	golang.org/x/text v0.1.1-0.20171102192421-88f656faf3f3 // indirect
 	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
-	gopkg.in/yaml.v2 v2.0.0-20170812160011-eb3733d160e7 // indirect
+	gopkg.in/yaml.v2 v2.0.0-20170812160011-eb3733d160e7
 )
@@ -0,0 +1,96 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// Api sends requests to the resources linked from a server's root resource, identified by link relation. A relation
+// which the root resource does not provide is resolved using the server's well-known path for it.
+type Api struct {
+	client Client
+	paths  map[string]string
+}
+
+// NewApi returns an Api which sends requests using the given client. The given well-known paths are keyed by link
+// relation, relative to the server URL, and may be URI templates.
+func NewApi(client Client, paths map[string]string) *Api {
+	return &Api{client: client, paths: paths}
+}
+
+// Href returns the URL of the link with the given relation, falling back to the well-known path of the link if
+// the server's root resource does not provide it.
+func (a *Api) Href(ctx context.Context, rel string, params map[string]string) (string, error) {
+	href, err := a.client.Link(ctx, rel, params)
+	var missingLinkError *MissingLinkError
+	if errors.As(err, &missingLinkError) {
+		if path, ok := a.paths[rel]; ok {
+			return a.client.ServerUrl() + ExpandTemplate(path, params), nil
+		}
+	}
+	return href, err
+}
+
+// Get decodes the resource linked with the given relation into the given result.
+func (a *Api) Get(ctx context.Context, rel string, params map[string]string, result interface{}) error {
+	href, err := a.Href(ctx, rel, params)
+	if err != nil {
+		return err
+	}
+	return a.client.Get(ctx, href, result)
+}
+
+// Collect decodes the items of all pages of the collection linked with the given relation into the slice pointed to
+// by items, as for Client.Collect.
+func (a *Api) Collect(ctx context.Context, rel string, params map[string]string, embeddedName string, items interface{}) error {
+	href, err := a.Href(ctx, rel, params)
+	if err != nil {
+		return err
+	}
+	return a.client.Collect(ctx, href, embeddedName, items)
+}
+
+// Send sends a request with the given method to the link with the given relation, accepting any successful status.
+// The request may be further configured by the given function, which may be nil.
+func (a *Api) Send(ctx context.Context, method string, rel string, params map[string]string, configure func(*httpclient.Request), result interface{}) error {
+	href, err := a.Href(ctx, rel, params)
+	if err != nil {
+		return err
+	}
+	request := httpclient.NewRequest(method, href).AcceptingAnySuccess()
+	if configure != nil {
+		configure(request)
+	}
+	return a.client.Do(ctx, request, result)
+}
+
+// Delete deletes the resource linked with the given relation.
+func (a *Api) Delete(ctx context.Context, rel string, params map[string]string) error {
+	return a.Send(ctx, http.MethodDelete, rel, params, nil, nil)
+}
+
+// JSONBody returns a function, for use with Send, which sets the JSON body of a request to the given value.
+func JSONBody(value interface{}) func(*httpclient.Request) {
+	return func(request *httpclient.Request) {
+		request.WithJSONBody(value)
+	}
+}
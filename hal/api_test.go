@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+)
+
+var _ = Describe("Api", func() {
+	const serverUrl = "https://skipper.example.com/api"
+
+	var (
+		fakeAuthClient *httpclientfakes.FakeAuthenticatedClient
+		responses      map[string]string
+		api            *hal.Api
+		ctx            context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		responses = map[string]string{
+			"GET " + serverUrl + "/": `{"_links": {
+				"packages": {"href": "https://skipper.example.com/api/packages"}
+			}}`,
+		}
+
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedStub = func(ctx context.Context, request *httpclient.Request, token string) (*http.Response, error) {
+			body, ok := responses[request.Method()+" "+request.URL()]
+			if !ok {
+				return nil, errors.New("not found: " + request.Method() + " " + request.URL())
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		api = hal.NewApi(hal.NewClient(fakeAuthClient, serverUrl, "access-token"), map[string]string{
+			"packages":         "/packages/fallback",
+			"release/name":     "/release/{name}",
+			"release/rollback": "/release/rollback",
+		})
+	})
+
+	lastRequest := func() *httpclient.Request {
+		_, request, _ := fakeAuthClient.DoAuthenticatedArgsForCall(fakeAuthClient.DoAuthenticatedCallCount() - 1)
+		return request
+	}
+
+	Describe("Href", func() {
+		It("should prefer the link provided by the root resource", func() {
+			Expect(api.Href(ctx, "packages", nil)).To(Equal(serverUrl + "/packages"))
+		})
+
+		It("should fall back to the well-known path of a missing link", func() {
+			Expect(api.Href(ctx, "release/name", map[string]string{"name": "ticktock"})).To(Equal(serverUrl + "/release/ticktock"))
+		})
+
+		It("should report a missing link with no well-known path", func() {
+			_, err := api.Href(ctx, "deployers", nil)
+			Expect(err).To(MatchError("Server at 'https://skipper.example.com/api' does not provide a 'deployers' link"))
+		})
+	})
+
+	Describe("Get", func() {
+		It("should decode the linked resource", func() {
+			responses["GET "+serverUrl+"/release/ticktock"] = `{"name": "ticktock"}`
+			var result item
+			Expect(api.Get(ctx, "release/name", map[string]string{"name": "ticktock"}, &result)).To(Succeed())
+			Expect(result.Name).To(Equal("ticktock"))
+		})
+	})
+
+	Describe("Collect", func() {
+		It("should collect the items of the linked collection", func() {
+			responses["GET "+serverUrl+"/packages"] = `{"_embedded": {"packageMetadata": [{"name": "a"}, {"name": "b"}]}}`
+			var items []item
+			Expect(api.Collect(ctx, "packages", nil, "", &items)).To(Succeed())
+			Expect(items).To(Equal([]item{{Name: "a"}, {Name: "b"}}))
+		})
+	})
+
+	Describe("Send", func() {
+		It("should send a configured request which accepts any success", func() {
+			responses["POST "+serverUrl+"/release/rollback"] = `{"name": "ticktock"}`
+			var result item
+			Expect(api.Send(ctx, http.MethodPost, "release/rollback", nil, hal.JSONBody(map[string]int{"version": 2}), &result)).To(Succeed())
+			Expect(result.Name).To(Equal("ticktock"))
+			Expect(lastRequest().Body()).To(Equal(`{"version":2}`))
+			Expect(lastRequest().Header("Content-Type")).To(Equal(httpclient.ContentTypeJSON))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should delete the linked resource", func() {
+			responses["DELETE "+serverUrl+"/release/ticktock"] = ``
+			Expect(api.Delete(ctx, "release/name", map[string]string{"name": "ticktock"})).To(Succeed())
+			Expect(lastRequest().Method()).To(Equal(http.MethodDelete))
+		})
+	})
+})
@@ -0,0 +1,244 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+const acceptHal = "application/hal+json, application/json"
+
+// Page describes the position of a page of a paged HAL collection.
+type Page struct {
+	Size          int `json:"size"`
+	TotalElements int `json:"totalElements"`
+	TotalPages    int `json:"totalPages"`
+	Number        int `json:"number"`
+}
+
+// Resource holds the links of a HAL resource. Embed it in structs describing specific resources.
+type Resource struct {
+	Links Links `json:"_links,omitempty"`
+}
+
+// PagedResource is a page of a HAL collection. The items of the page are embedded under one or more names.
+type PagedResource struct {
+	Resource
+	Embedded map[string]json.RawMessage `json:"_embedded,omitempty"`
+	Page     *Page                      `json:"page,omitempty"`
+}
+
+// Client accesses the HAL resources of a server, such as a dataflow or Skipper server.
+//
+//go:generate counterfeiter -o halfakes/fake_client.go . Client
+type Client interface {
+	// ServerUrl returns the URL of the server's root resource.
+	ServerUrl() string
+
+	// Get decodes the resource at the given URL into the given result.
+	Get(ctx context.Context, url string, result interface{}) error
+
+	// Do sends the given request and, unless result is nil, decodes any response body into result.
+	Do(ctx context.Context, request *httpclient.Request, result interface{}) error
+
+	// Text sends the given request and returns the response body as text. A body consisting of a JSON string is
+	// decoded.
+	Text(ctx context.Context, request *httpclient.Request) (string, error)
+
+	// Link returns the href of the link with the given relation in the server's root resource, expanded with the
+	// given parameters if the link is templated. If the root resource has no such link, the error is a
+	// *MissingLinkError.
+	Link(ctx context.Context, rel string, params map[string]string) (string, error)
+
+	// Collect decodes the items of all pages of the collection at the given URL into the slice pointed to by items.
+	// Items are embedded in each page under the given name or, if the name is empty, under a page's only name.
+	Collect(ctx context.Context, url string, embeddedName string, items interface{}) error
+
+	// Items returns an iterator over the items of the collection at the given URL which fetches pages as they are
+	// needed. Items are embedded as for Collect.
+	Items(ctx context.Context, url string, embeddedName string) Iterator
+}
+
+type client struct {
+	authClient  httpclient.AuthenticatedClient
+	serverUrl   string
+	accessToken string
+
+	rootLinks Links
+	rootMutex sync.Mutex
+}
+
+// NewClient returns a client for the HAL resources of the server at the given URL.
+func NewClient(authClient httpclient.AuthenticatedClient, serverUrl string, accessToken string) *client {
+	return &client{
+		authClient:  authClient,
+		serverUrl:   strings.TrimSuffix(serverUrl, "/"),
+		accessToken: accessToken,
+	}
+}
+
+func (c *client) ServerUrl() string {
+	return c.serverUrl
+}
+
+func (c *client) Get(ctx context.Context, url string, result interface{}) error {
+	return c.Do(ctx, httpclient.NewRequest(http.MethodGet, url), result)
+}
+
+func (c *client) Do(ctx context.Context, request *httpclient.Request, result interface{}) error {
+	request = withAccept(request, acceptHal)
+	resp, err := c.authClient.DoAuthenticated(ctx, request, c.accessToken)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result == nil {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Cannot read response body from '%s': %s", request.URL(), err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("Invalid response JSON from '%s': %s, response body: '%s'", request.URL(), err, string(body))
+	}
+	return nil
+}
+
+func (c *client) Text(ctx context.Context, request *httpclient.Request) (string, error) {
+	request = withAccept(request, "text/plain, application/json")
+	resp, err := c.authClient.DoAuthenticated(ctx, request, c.accessToken)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("Cannot read response body from '%s': %s", request.URL(), err)
+	}
+	var text string
+	if err := json.Unmarshal(body, &text); err == nil {
+		return text, nil
+	}
+	return string(body), nil
+}
+
+// withAccept returns a copy of the given request which accepts the given media types, unless the request already
+// specifies which media types it accepts. The caller's request is not modified.
+func withAccept(request *httpclient.Request, accept string) *httpclient.Request {
+	if request.Header("Accept") != "" {
+		return request
+	}
+	return request.Clone().WithHeader("Accept", accept)
+}
+
+// MissingLinkError is returned when a server's root resource does not provide a requested link.
+type MissingLinkError struct {
+	ServerUrl string
+	Rel       string
+}
+
+func (e *MissingLinkError) Error() string {
+	return fmt.Sprintf("Server at '%s' does not provide a '%s' link", e.ServerUrl, e.Rel)
+}
+
+func (c *client) Link(ctx context.Context, rel string, params map[string]string) (string, error) {
+	links, err := c.links(ctx)
+	if err != nil {
+		return "", err
+	}
+	href, ok := links.Href(rel, params)
+	if !ok {
+		return "", &MissingLinkError{ServerUrl: c.serverUrl, Rel: rel}
+	}
+	return resolve(c.serverUrl+"/", href), nil
+}
+
+// links returns the links of the server's root resource, which are fetched once.
+func (c *client) links(ctx context.Context) (Links, error) {
+	c.rootMutex.Lock()
+	defer c.rootMutex.Unlock()
+	if c.rootLinks != nil {
+		return c.rootLinks, nil
+	}
+
+	var root Resource
+	if err := c.Get(ctx, c.serverUrl+"/", &root); err != nil {
+		return nil, err
+	}
+	if root.Links == nil {
+		root.Links = Links{}
+	}
+	c.rootLinks = root.Links
+	return c.rootLinks, nil
+}
+
+func (c *client) Collect(ctx context.Context, url string, embeddedName string, items interface{}) error {
+	slice := reflect.ValueOf(items)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("Collect requires a pointer to a slice, not %T", items)
+	}
+	slice = slice.Elem()
+	itemType := slice.Type().Elem()
+
+	iterator := c.Items(ctx, url, embeddedName)
+	for {
+		item := reflect.New(itemType)
+		if !iterator.Next(item.Interface()) {
+			break
+		}
+		slice.Set(reflect.Append(slice, item.Elem()))
+	}
+	return iterator.Err()
+}
+
+func (c *client) Items(ctx context.Context, url string, embeddedName string) Iterator {
+	return &iterator{
+		client:       c,
+		ctx:          ctx,
+		next:         url,
+		embeddedName: embeddedName,
+	}
+}
+
+// resolve returns the given href resolved relative to the given base URL.
+func resolve(base string, href string) string {
+	baseUrl, err := url.Parse(base)
+	if err != nil {
+		return href
+	}
+	hrefUrl, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return baseUrl.ResolveReference(hrefUrl).String()
+}
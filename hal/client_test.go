@@ -0,0 +1,261 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+)
+
+type item struct {
+	Name string `json:"name"`
+}
+
+var _ = Describe("Client", func() {
+	const (
+		serverUrl   = "https://dataflow.example.com"
+		accessToken = "access-token"
+	)
+
+	var (
+		fakeAuthClient *httpclientfakes.FakeAuthenticatedClient
+		responses      map[string]string
+		client         hal.Client
+		ctx            context.Context
+		err            error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		responses = map[string]string{
+			serverUrl + "/": `{"_links": {
+				"about": {"href": "https://dataflow.example.com/about"},
+				"streams/definitions": {"href": "https://dataflow.example.com/streams/definitions"},
+				"streams/definitions/definition": {"href": "https://dataflow.example.com/streams/definitions/{name}", "templated": true},
+				"tasks/executions/relative": {"href": "/tasks/executions"}
+			}}`,
+			serverUrl + "/streams/definitions": `{
+				"_embedded": {"streamDefinitionResourceList": [{"name": "a"}, {"name": "b"}]},
+				"_links": {"next": {"href": "https://dataflow.example.com/streams/definitions?page=1"}},
+				"page": {"size": 2, "totalElements": 3, "totalPages": 2, "number": 0}
+			}`,
+			serverUrl + "/streams/definitions?page=1": `{
+				"_embedded": {"streamDefinitionResourceList": [{"name": "c"}]},
+				"_links": {"self": {"href": "https://dataflow.example.com/streams/definitions?page=1"}},
+				"page": {"size": 2, "totalElements": 3, "totalPages": 2, "number": 1}
+			}`,
+		}
+
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedStub = func(ctx context.Context, request *httpclient.Request, token string) (*http.Response, error) {
+			body, ok := responses[request.URL()]
+			if !ok {
+				return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"}, errors.New("not found: " + request.URL())
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		client = hal.NewClient(fakeAuthClient, serverUrl+"/", accessToken)
+	})
+
+	Describe("Get", func() {
+		It("should decode the resource", func() {
+			var about struct {
+				hal.Resource
+				Name string `json:"name"`
+			}
+			responses[serverUrl+"/about"] = `{"name": "dataflow", "_links": {"self": {"href": "https://dataflow.example.com/about"}}}`
+
+			Expect(client.Get(ctx, serverUrl+"/about", &about)).To(Succeed())
+			Expect(about.Name).To(Equal("dataflow"))
+			Expect(about.Links["self"].Href).To(Equal(serverUrl + "/about"))
+		})
+
+		It("should send the access token and accept HAL", func() {
+			Expect(client.Get(ctx, serverUrl+"/", &hal.Resource{})).To(Succeed())
+			_, request, token := fakeAuthClient.DoAuthenticatedArgsForCall(0)
+			Expect(token).To(Equal(accessToken))
+			Expect(request.Method()).To(Equal(http.MethodGet))
+		})
+
+		It("should report invalid JSON", func() {
+			responses[serverUrl+"/about"] = `not json`
+			err = client.Get(ctx, serverUrl+"/about", &hal.Resource{})
+			Expect(err).To(MatchError(HavePrefix("Invalid response JSON from 'https://dataflow.example.com/about': ")))
+		})
+
+		It("should propagate request errors", func() {
+			err = client.Get(ctx, serverUrl+"/missing", &hal.Resource{})
+			Expect(err).To(MatchError("not found: https://dataflow.example.com/missing"))
+		})
+	})
+
+	Describe("Do", func() {
+		var request *httpclient.Request
+
+		BeforeEach(func() {
+			responses[serverUrl+"/about"] = `{"name": "dataflow"}`
+			request = httpclient.NewRequest(http.MethodGet, serverUrl+"/about")
+		})
+
+		It("should accept HAL without modifying the caller's request", func() {
+			Expect(client.Do(ctx, request, &item{})).To(Succeed())
+			_, sent, _ := fakeAuthClient.DoAuthenticatedArgsForCall(0)
+			Expect(sent.Header("Accept")).To(Equal("application/hal+json, application/json"))
+			Expect(request.Header("Accept")).To(BeEmpty())
+		})
+
+		It("should not add an Accept header to a request reused across calls", func() {
+			Expect(client.Do(ctx, request, &item{})).To(Succeed())
+			Expect(client.Do(ctx, request, &item{})).To(Succeed())
+			_, sent, _ := fakeAuthClient.DoAuthenticatedArgsForCall(1)
+			Expect(sent.Header("Accept")).To(Equal("application/hal+json, application/json"))
+		})
+
+		It("should respect an Accept header set by the caller", func() {
+			request.WithHeader("Accept", "application/json")
+			Expect(client.Do(ctx, request, &item{})).To(Succeed())
+			_, sent, _ := fakeAuthClient.DoAuthenticatedArgsForCall(0)
+			Expect(sent.Header("Accept")).To(Equal("application/json"))
+		})
+	})
+
+	Describe("Text", func() {
+		It("should accept text without modifying the caller's request", func() {
+			responses[serverUrl+"/log"] = `"line 1\nline 2"`
+			request := httpclient.NewRequest(http.MethodGet, serverUrl+"/log")
+
+			Expect(client.Text(ctx, request)).To(Equal("line 1\nline 2"))
+			_, sent, _ := fakeAuthClient.DoAuthenticatedArgsForCall(0)
+			Expect(sent.Header("Accept")).To(Equal("text/plain, application/json"))
+			Expect(request.Header("Accept")).To(BeEmpty())
+		})
+	})
+
+	Describe("Link", func() {
+		It("should resolve links from the root resource", func() {
+			Expect(client.Link(ctx, "about", nil)).To(Equal(serverUrl + "/about"))
+		})
+
+		It("should expand templated links", func() {
+			Expect(client.Link(ctx, "streams/definitions/definition", map[string]string{"name": "ticktock"})).To(Equal(serverUrl + "/streams/definitions/ticktock"))
+		})
+
+		It("should resolve relative links against the server URL", func() {
+			Expect(client.Link(ctx, "tasks/executions/relative", nil)).To(Equal(serverUrl + "/tasks/executions"))
+		})
+
+		It("should fetch the root resource once", func() {
+			_, err = client.Link(ctx, "about", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = client.Link(ctx, "streams/definitions", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeAuthClient.DoAuthenticatedCallCount()).To(Equal(1))
+		})
+
+		It("should report a missing link", func() {
+			_, err = client.Link(ctx, "jobs/executions", nil)
+			Expect(err).To(MatchError("Server at 'https://dataflow.example.com' does not provide a 'jobs/executions' link"))
+		})
+	})
+
+	Describe("Collect", func() {
+		It("should collect the items of all pages", func() {
+			var items []item
+			Expect(client.Collect(ctx, serverUrl+"/streams/definitions", "streamDefinitionResourceList", &items)).To(Succeed())
+			Expect(items).To(Equal([]item{{"a"}, {"b"}, {"c"}}))
+		})
+
+		It("should use the only embedded name if none is given", func() {
+			var items []*item
+			Expect(client.Collect(ctx, serverUrl+"/streams/definitions", "", &items)).To(Succeed())
+			Expect(items).To(HaveLen(3))
+			Expect(items[2].Name).To(Equal("c"))
+		})
+
+		It("should collect no items from an empty collection", func() {
+			responses[serverUrl+"/empty"] = `{"_links": {}, "page": {"size": 20, "totalElements": 0, "totalPages": 0, "number": 0}}`
+			var items []item
+			Expect(client.Collect(ctx, serverUrl+"/empty", "streamDefinitionResourceList", &items)).To(Succeed())
+			Expect(items).To(BeEmpty())
+		})
+
+		It("should require a pointer to a slice", func() {
+			var items []item
+			Expect(client.Collect(ctx, serverUrl+"/streams/definitions", "", items)).To(MatchError("Collect requires a pointer to a slice, not []hal_test.item"))
+		})
+
+		It("should report an error fetching a later page", func() {
+			delete(responses, serverUrl+"/streams/definitions?page=1")
+			var items []item
+			err = client.Collect(ctx, serverUrl+"/streams/definitions", "", &items)
+			Expect(err).To(MatchError("not found: https://dataflow.example.com/streams/definitions?page=1"))
+			Expect(items).To(HaveLen(2))
+		})
+	})
+
+	Describe("Items", func() {
+		It("should fetch pages lazily", func() {
+			iterator := client.Items(ctx, serverUrl+"/streams/definitions", "streamDefinitionResourceList")
+			Expect(fakeAuthClient.DoAuthenticatedCallCount()).To(Equal(0))
+
+			var it item
+			Expect(iterator.Next(&it)).To(BeTrue())
+			Expect(it.Name).To(Equal("a"))
+			Expect(iterator.Page()).To(Equal(&hal.Page{Size: 2, TotalElements: 3, TotalPages: 2, Number: 0}))
+			Expect(iterator.Next(&it)).To(BeTrue())
+			Expect(fakeAuthClient.DoAuthenticatedCallCount()).To(Equal(1))
+
+			Expect(iterator.Next(&it)).To(BeTrue())
+			Expect(it.Name).To(Equal("c"))
+			Expect(fakeAuthClient.DoAuthenticatedCallCount()).To(Equal(2))
+
+			Expect(iterator.Next(&it)).To(BeFalse())
+			Expect(iterator.Err()).NotTo(HaveOccurred())
+		})
+
+		It("should not follow a next link back to a page already fetched", func() {
+			responses[serverUrl+"/loop"] = `{"_embedded": {"items": [{"name": "a"}]}, "_links": {"next": {"href": "/loop"}}}`
+			var items []item
+			Expect(client.Collect(ctx, serverUrl+"/loop", "", &items)).To(Succeed())
+			Expect(items).To(HaveLen(1))
+		})
+
+		It("should report an item which cannot be decoded", func() {
+			responses[serverUrl+"/bad"] = `{"_embedded": {"items": [{"name": 1}]}}`
+			iterator := client.Items(ctx, serverUrl+"/bad", "")
+			Expect(iterator.Next(&item{})).To(BeFalse())
+			Expect(iterator.Err()).To(MatchError(HavePrefix("Invalid item JSON in collection: ")))
+		})
+
+		It("should report items embedded under several names", func() {
+			responses[serverUrl+"/ambiguous"] = `{"_embedded": {"a": [], "b": []}}`
+			iterator := client.Items(ctx, serverUrl+"/ambiguous", "")
+			Expect(iterator.Next(&item{})).To(BeFalse())
+			Expect(iterator.Err()).To(MatchError("Invalid collection at 'https://dataflow.example.com/ambiguous': items are embedded under more than one name"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package hal_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestHal(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hal Suite")
+}
@@ -0,0 +1,582 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package halfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+type FakeClient struct {
+	CollectStub        func(context.Context, string, string, interface{}) error
+	collectMutex       sync.RWMutex
+	collectArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 interface{}
+	}
+	collectReturns struct {
+		result1 error
+	}
+	collectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DoStub        func(context.Context, *httpclient.Request, interface{}) error
+	doMutex       sync.RWMutex
+	doArgsForCall []struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+		arg3 interface{}
+	}
+	doReturns struct {
+		result1 error
+	}
+	doReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(context.Context, string, interface{}) error
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 interface{}
+	}
+	getReturns struct {
+		result1 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ItemsStub        func(context.Context, string, string) hal.Iterator
+	itemsMutex       sync.RWMutex
+	itemsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	itemsReturns struct {
+		result1 hal.Iterator
+	}
+	itemsReturnsOnCall map[int]struct {
+		result1 hal.Iterator
+	}
+	LinkStub        func(context.Context, string, map[string]string) (string, error)
+	linkMutex       sync.RWMutex
+	linkArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}
+	linkReturns struct {
+		result1 string
+		result2 error
+	}
+	linkReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ServerUrlStub        func() string
+	serverUrlMutex       sync.RWMutex
+	serverUrlArgsForCall []struct {
+	}
+	serverUrlReturns struct {
+		result1 string
+	}
+	serverUrlReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TextStub        func(context.Context, *httpclient.Request) (string, error)
+	textMutex       sync.RWMutex
+	textArgsForCall []struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+	}
+	textReturns struct {
+		result1 string
+		result2 error
+	}
+	textReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) Collect(arg1 context.Context, arg2 string, arg3 string, arg4 interface{}) error {
+	fake.collectMutex.Lock()
+	ret, specificReturn := fake.collectReturnsOnCall[len(fake.collectArgsForCall)]
+	fake.collectArgsForCall = append(fake.collectArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 interface{}
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CollectStub
+	fakeReturns := fake.collectReturns
+	fake.recordInvocation("Collect", []interface{}{arg1, arg2, arg3, arg4})
+	fake.collectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CollectCallCount() int {
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	return len(fake.collectArgsForCall)
+}
+
+func (fake *FakeClient) CollectCalls(stub func(context.Context, string, string, interface{}) error) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = stub
+}
+
+func (fake *FakeClient) CollectArgsForCall(i int) (context.Context, string, string, interface{}) {
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	argsForCall := fake.collectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) CollectReturns(result1 error) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = nil
+	fake.collectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CollectReturnsOnCall(i int, result1 error) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = nil
+	if fake.collectReturnsOnCall == nil {
+		fake.collectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.collectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Do(arg1 context.Context, arg2 *httpclient.Request, arg3 interface{}) error {
+	fake.doMutex.Lock()
+	ret, specificReturn := fake.doReturnsOnCall[len(fake.doArgsForCall)]
+	fake.doArgsForCall = append(fake.doArgsForCall, struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+		arg3 interface{}
+	}{arg1, arg2, arg3})
+	stub := fake.DoStub
+	fakeReturns := fake.doReturns
+	fake.recordInvocation("Do", []interface{}{arg1, arg2, arg3})
+	fake.doMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DoCallCount() int {
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	return len(fake.doArgsForCall)
+}
+
+func (fake *FakeClient) DoCalls(stub func(context.Context, *httpclient.Request, interface{}) error) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = stub
+}
+
+func (fake *FakeClient) DoArgsForCall(i int) (context.Context, *httpclient.Request, interface{}) {
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	argsForCall := fake.doArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DoReturns(result1 error) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = nil
+	fake.doReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DoReturnsOnCall(i int, result1 error) {
+	fake.doMutex.Lock()
+	defer fake.doMutex.Unlock()
+	fake.DoStub = nil
+	if fake.doReturnsOnCall == nil {
+		fake.doReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.doReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Get(arg1 context.Context, arg2 string, arg3 interface{}) error {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 interface{}
+	}{arg1, arg2, arg3})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeClient) GetCalls(stub func(context.Context, string, interface{}) error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeClient) GetArgsForCall(i int) (context.Context, string, interface{}) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) GetReturns(result1 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) GetReturnsOnCall(i int, result1 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Items(arg1 context.Context, arg2 string, arg3 string) hal.Iterator {
+	fake.itemsMutex.Lock()
+	ret, specificReturn := fake.itemsReturnsOnCall[len(fake.itemsArgsForCall)]
+	fake.itemsArgsForCall = append(fake.itemsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ItemsStub
+	fakeReturns := fake.itemsReturns
+	fake.recordInvocation("Items", []interface{}{arg1, arg2, arg3})
+	fake.itemsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ItemsCallCount() int {
+	fake.itemsMutex.RLock()
+	defer fake.itemsMutex.RUnlock()
+	return len(fake.itemsArgsForCall)
+}
+
+func (fake *FakeClient) ItemsCalls(stub func(context.Context, string, string) hal.Iterator) {
+	fake.itemsMutex.Lock()
+	defer fake.itemsMutex.Unlock()
+	fake.ItemsStub = stub
+}
+
+func (fake *FakeClient) ItemsArgsForCall(i int) (context.Context, string, string) {
+	fake.itemsMutex.RLock()
+	defer fake.itemsMutex.RUnlock()
+	argsForCall := fake.itemsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ItemsReturns(result1 hal.Iterator) {
+	fake.itemsMutex.Lock()
+	defer fake.itemsMutex.Unlock()
+	fake.ItemsStub = nil
+	fake.itemsReturns = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) ItemsReturnsOnCall(i int, result1 hal.Iterator) {
+	fake.itemsMutex.Lock()
+	defer fake.itemsMutex.Unlock()
+	fake.ItemsStub = nil
+	if fake.itemsReturnsOnCall == nil {
+		fake.itemsReturnsOnCall = make(map[int]struct {
+			result1 hal.Iterator
+		})
+	}
+	fake.itemsReturnsOnCall[i] = struct {
+		result1 hal.Iterator
+	}{result1}
+}
+
+func (fake *FakeClient) Link(arg1 context.Context, arg2 string, arg3 map[string]string) (string, error) {
+	fake.linkMutex.Lock()
+	ret, specificReturn := fake.linkReturnsOnCall[len(fake.linkArgsForCall)]
+	fake.linkArgsForCall = append(fake.linkArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.LinkStub
+	fakeReturns := fake.linkReturns
+	fake.recordInvocation("Link", []interface{}{arg1, arg2, arg3})
+	fake.linkMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) LinkCallCount() int {
+	fake.linkMutex.RLock()
+	defer fake.linkMutex.RUnlock()
+	return len(fake.linkArgsForCall)
+}
+
+func (fake *FakeClient) LinkCalls(stub func(context.Context, string, map[string]string) (string, error)) {
+	fake.linkMutex.Lock()
+	defer fake.linkMutex.Unlock()
+	fake.LinkStub = stub
+}
+
+func (fake *FakeClient) LinkArgsForCall(i int) (context.Context, string, map[string]string) {
+	fake.linkMutex.RLock()
+	defer fake.linkMutex.RUnlock()
+	argsForCall := fake.linkArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) LinkReturns(result1 string, result2 error) {
+	fake.linkMutex.Lock()
+	defer fake.linkMutex.Unlock()
+	fake.LinkStub = nil
+	fake.linkReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) LinkReturnsOnCall(i int, result1 string, result2 error) {
+	fake.linkMutex.Lock()
+	defer fake.linkMutex.Unlock()
+	fake.LinkStub = nil
+	if fake.linkReturnsOnCall == nil {
+		fake.linkReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.linkReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ServerUrl() string {
+	fake.serverUrlMutex.Lock()
+	ret, specificReturn := fake.serverUrlReturnsOnCall[len(fake.serverUrlArgsForCall)]
+	fake.serverUrlArgsForCall = append(fake.serverUrlArgsForCall, struct {
+	}{})
+	stub := fake.ServerUrlStub
+	fakeReturns := fake.serverUrlReturns
+	fake.recordInvocation("ServerUrl", []interface{}{})
+	fake.serverUrlMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ServerUrlCallCount() int {
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	return len(fake.serverUrlArgsForCall)
+}
+
+func (fake *FakeClient) ServerUrlCalls(stub func() string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = stub
+}
+
+func (fake *FakeClient) ServerUrlReturns(result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	fake.serverUrlReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) ServerUrlReturnsOnCall(i int, result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	if fake.serverUrlReturnsOnCall == nil {
+		fake.serverUrlReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.serverUrlReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) Text(arg1 context.Context, arg2 *httpclient.Request) (string, error) {
+	fake.textMutex.Lock()
+	ret, specificReturn := fake.textReturnsOnCall[len(fake.textArgsForCall)]
+	fake.textArgsForCall = append(fake.textArgsForCall, struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+	}{arg1, arg2})
+	stub := fake.TextStub
+	fakeReturns := fake.textReturns
+	fake.recordInvocation("Text", []interface{}{arg1, arg2})
+	fake.textMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TextCallCount() int {
+	fake.textMutex.RLock()
+	defer fake.textMutex.RUnlock()
+	return len(fake.textArgsForCall)
+}
+
+func (fake *FakeClient) TextCalls(stub func(context.Context, *httpclient.Request) (string, error)) {
+	fake.textMutex.Lock()
+	defer fake.textMutex.Unlock()
+	fake.TextStub = stub
+}
+
+func (fake *FakeClient) TextArgsForCall(i int) (context.Context, *httpclient.Request) {
+	fake.textMutex.RLock()
+	defer fake.textMutex.RUnlock()
+	argsForCall := fake.textArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) TextReturns(result1 string, result2 error) {
+	fake.textMutex.Lock()
+	defer fake.textMutex.Unlock()
+	fake.TextStub = nil
+	fake.textReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TextReturnsOnCall(i int, result1 string, result2 error) {
+	fake.textMutex.Lock()
+	defer fake.textMutex.Unlock()
+	fake.TextStub = nil
+	if fake.textReturnsOnCall == nil {
+		fake.textReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.textReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	fake.doMutex.RLock()
+	defer fake.doMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.itemsMutex.RLock()
+	defer fake.itemsMutex.RUnlock()
+	fake.linkMutex.RLock()
+	defer fake.linkMutex.RUnlock()
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	fake.textMutex.RLock()
+	defer fake.textMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ hal.Client = new(FakeClient)
@@ -0,0 +1,241 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package halfakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+type FakeIterator struct {
+	ErrStub        func() error
+	errMutex       sync.RWMutex
+	errArgsForCall []struct {
+	}
+	errReturns struct {
+		result1 error
+	}
+	errReturnsOnCall map[int]struct {
+		result1 error
+	}
+	NextStub        func(interface{}) bool
+	nextMutex       sync.RWMutex
+	nextArgsForCall []struct {
+		arg1 interface{}
+	}
+	nextReturns struct {
+		result1 bool
+	}
+	nextReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PageStub        func() *hal.Page
+	pageMutex       sync.RWMutex
+	pageArgsForCall []struct {
+	}
+	pageReturns struct {
+		result1 *hal.Page
+	}
+	pageReturnsOnCall map[int]struct {
+		result1 *hal.Page
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIterator) Err() error {
+	fake.errMutex.Lock()
+	ret, specificReturn := fake.errReturnsOnCall[len(fake.errArgsForCall)]
+	fake.errArgsForCall = append(fake.errArgsForCall, struct {
+	}{})
+	stub := fake.ErrStub
+	fakeReturns := fake.errReturns
+	fake.recordInvocation("Err", []interface{}{})
+	fake.errMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIterator) ErrCallCount() int {
+	fake.errMutex.RLock()
+	defer fake.errMutex.RUnlock()
+	return len(fake.errArgsForCall)
+}
+
+func (fake *FakeIterator) ErrCalls(stub func() error) {
+	fake.errMutex.Lock()
+	defer fake.errMutex.Unlock()
+	fake.ErrStub = stub
+}
+
+func (fake *FakeIterator) ErrReturns(result1 error) {
+	fake.errMutex.Lock()
+	defer fake.errMutex.Unlock()
+	fake.ErrStub = nil
+	fake.errReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIterator) ErrReturnsOnCall(i int, result1 error) {
+	fake.errMutex.Lock()
+	defer fake.errMutex.Unlock()
+	fake.ErrStub = nil
+	if fake.errReturnsOnCall == nil {
+		fake.errReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.errReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIterator) Next(arg1 interface{}) bool {
+	fake.nextMutex.Lock()
+	ret, specificReturn := fake.nextReturnsOnCall[len(fake.nextArgsForCall)]
+	fake.nextArgsForCall = append(fake.nextArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.NextStub
+	fakeReturns := fake.nextReturns
+	fake.recordInvocation("Next", []interface{}{arg1})
+	fake.nextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIterator) NextCallCount() int {
+	fake.nextMutex.RLock()
+	defer fake.nextMutex.RUnlock()
+	return len(fake.nextArgsForCall)
+}
+
+func (fake *FakeIterator) NextCalls(stub func(interface{}) bool) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = stub
+}
+
+func (fake *FakeIterator) NextArgsForCall(i int) interface{} {
+	fake.nextMutex.RLock()
+	defer fake.nextMutex.RUnlock()
+	argsForCall := fake.nextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeIterator) NextReturns(result1 bool) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = nil
+	fake.nextReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeIterator) NextReturnsOnCall(i int, result1 bool) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = nil
+	if fake.nextReturnsOnCall == nil {
+		fake.nextReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.nextReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeIterator) Page() *hal.Page {
+	fake.pageMutex.Lock()
+	ret, specificReturn := fake.pageReturnsOnCall[len(fake.pageArgsForCall)]
+	fake.pageArgsForCall = append(fake.pageArgsForCall, struct {
+	}{})
+	stub := fake.PageStub
+	fakeReturns := fake.pageReturns
+	fake.recordInvocation("Page", []interface{}{})
+	fake.pageMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIterator) PageCallCount() int {
+	fake.pageMutex.RLock()
+	defer fake.pageMutex.RUnlock()
+	return len(fake.pageArgsForCall)
+}
+
+func (fake *FakeIterator) PageCalls(stub func() *hal.Page) {
+	fake.pageMutex.Lock()
+	defer fake.pageMutex.Unlock()
+	fake.PageStub = stub
+}
+
+func (fake *FakeIterator) PageReturns(result1 *hal.Page) {
+	fake.pageMutex.Lock()
+	defer fake.pageMutex.Unlock()
+	fake.PageStub = nil
+	fake.pageReturns = struct {
+		result1 *hal.Page
+	}{result1}
+}
+
+func (fake *FakeIterator) PageReturnsOnCall(i int, result1 *hal.Page) {
+	fake.pageMutex.Lock()
+	defer fake.pageMutex.Unlock()
+	fake.PageStub = nil
+	if fake.pageReturnsOnCall == nil {
+		fake.pageReturnsOnCall = make(map[int]struct {
+			result1 *hal.Page
+		})
+	}
+	fake.pageReturnsOnCall[i] = struct {
+		result1 *hal.Page
+	}{result1}
+}
+
+func (fake *FakeIterator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.errMutex.RLock()
+	defer fake.errMutex.RUnlock()
+	fake.nextMutex.RLock()
+	defer fake.nextMutex.RUnlock()
+	fake.pageMutex.RLock()
+	defer fake.pageMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIterator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ hal.Iterator = new(FakeIterator)
@@ -0,0 +1,157 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Iterator iterates over the items of a paged HAL collection. For example:
+//
+//	it := client.Items(ctx, url, "streamDefinitionResourceList")
+//	var stream StreamDefinition
+//	for it.Next(&stream) {
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+//go:generate counterfeiter -o halfakes/fake_iterator.go . Iterator
+type Iterator interface {
+	// Next decodes the next item into the given value, fetching the next page if necessary. It returns false when
+	// there are no more items or an error occurs.
+	Next(item interface{}) bool
+
+	// Err returns the error, if any, which ended the iteration.
+	Err() error
+
+	// Page returns the position of the most recently fetched page, or nil if the collection is not paged.
+	Page() *Page
+}
+
+type iterator struct {
+	client       *client
+	ctx          context.Context
+	next         string
+	embeddedName string
+
+	items   []json.RawMessage
+	page    *Page
+	fetched map[string]bool
+	err     error
+}
+
+func (it *iterator) Next(item interface{}) bool {
+	for len(it.items) == 0 {
+		if it.err != nil || it.next == "" {
+			return false
+		}
+		it.fetch()
+	}
+
+	if err := json.Unmarshal(it.items[0], item); err != nil {
+		it.err = fmt.Errorf("Invalid item JSON in collection: %s, item: '%s'", err, string(it.items[0]))
+		return false
+	}
+	it.items = it.items[1:]
+	return true
+}
+
+func (it *iterator) Err() error {
+	return it.err
+}
+
+func (it *iterator) Page() *Page {
+	return it.page
+}
+
+// fetch fetches the next page and determines the URL of the page after that, if any.
+func (it *iterator) fetch() {
+	url := it.next
+	it.next = ""
+	if it.fetched == nil {
+		it.fetched = map[string]bool{}
+	}
+	it.fetched[url] = true
+
+	var page PagedResource
+	if err := it.client.Get(it.ctx, url, &page); err != nil {
+		it.err = err
+		return
+	}
+	it.page = page.Page
+
+	items, err := embeddedItems(page.Embedded, it.embeddedName)
+	if err != nil {
+		it.err = fmt.Errorf("Invalid collection at '%s': %s", url, err)
+		return
+	}
+	it.items = items
+
+	if next, ok := page.Links["next"]; ok {
+		nextUrl := resolve(url, next.Expand(nil))
+		// Guard against servers which link a page to itself or to an earlier page.
+		if !it.fetched[nextUrl] {
+			it.next = nextUrl
+		}
+	}
+}
+
+func embeddedItems(embedded map[string]json.RawMessage, name string) ([]json.RawMessage, error) {
+	if len(embedded) == 0 {
+		return nil, nil
+	}
+
+	if name == "" {
+		if len(embedded) > 1 {
+			return nil, fmt.Errorf("items are embedded under more than one name")
+		}
+		for embeddedName := range embedded {
+			name = embeddedName
+		}
+	}
+
+	raw, ok := embedded[name]
+	if !ok {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("embedded '%s' is not an array: %s", name, err)
+	}
+	return items, nil
+}
+
+// DecodeEmbedded decodes the items of the given page, embedded under the given name or, if the name is empty,
+// under the page's only name, into the slice pointed to by items.
+func DecodeEmbedded(page PagedResource, embeddedName string, items interface{}) error {
+	raw, err := embeddedItems(page.Embedded, embeddedName)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, items)
+}
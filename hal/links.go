@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Link is a HAL link. The href of a templated link is a URI template, as defined by RFC 6570.
+type Link struct {
+	Href      string `json:"href"`
+	Templated bool   `json:"templated,omitempty"`
+}
+
+// Links maps link relations to links. Where a resource has several links with the same relation, only the first is
+// retained.
+type Links map[string]Link
+
+func (l *Links) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	links := Links{}
+	for rel, value := range raw {
+		var link Link
+		if err := json.Unmarshal(value, &link); err == nil {
+			links[rel] = link
+			continue
+		}
+
+		var linkArray []Link
+		if err := json.Unmarshal(value, &linkArray); err != nil {
+			return err
+		}
+		if len(linkArray) > 0 {
+			links[rel] = linkArray[0]
+		}
+	}
+	*l = links
+	return nil
+}
+
+// Href returns the href of the link with the given relation, expanded using the given parameters if the link is
+// templated, and whether the link is present.
+func (l Links) Href(rel string, params map[string]string) (string, bool) {
+	link, ok := l[rel]
+	if !ok {
+		return "", false
+	}
+	return link.Expand(params), true
+}
+
+// Expand returns the href of the link, expanding it using the given parameters if the link is templated. Template
+// variables without a corresponding parameter are omitted.
+func (l Link) Expand(params map[string]string) string {
+	if !l.Templated {
+		return l.Href
+	}
+	return ExpandTemplate(l.Href, params)
+}
+
+var templateExpression = regexp.MustCompile(`\{([+#./;?&]?)([^}]*)\}`)
+
+// ExpandTemplate expands the given URI template using the given parameters. Simple, reserved, fragment, label, path
+// segment, path-style, query, and query continuation expressions are supported. Value modifiers are ignored.
+func ExpandTemplate(template string, params map[string]string) string {
+	return templateExpression.ReplaceAllStringFunc(template, func(expression string) string {
+		match := templateExpression.FindStringSubmatch(expression)
+		operator, variables := match[1], strings.Split(match[2], ",")
+
+		var values []string
+		for _, variable := range variables {
+			name := strings.TrimSuffix(variable, "*")
+			if i := strings.Index(name, ":"); i >= 0 {
+				name = name[:i]
+			}
+			value, ok := params[name]
+			if !ok {
+				continue
+			}
+			if operator != "+" && operator != "#" {
+				value = url.QueryEscape(value)
+				value = strings.Replace(value, "+", "%20", -1)
+			}
+			switch operator {
+			case "?", "&", ";":
+				if value == "" && operator == ";" {
+					values = append(values, name)
+				} else {
+					values = append(values, name+"="+value)
+				}
+			default:
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return ""
+		}
+
+		switch operator {
+		case "", "+":
+			return strings.Join(values, ",")
+		case "#":
+			return "#" + strings.Join(values, ",")
+		case ".":
+			return "." + strings.Join(values, ".")
+		case "/":
+			return "/" + strings.Join(values, "/")
+		case ";":
+			return ";" + strings.Join(values, ";")
+		case "?":
+			return "?" + strings.Join(values, "&")
+		default: // "&"
+			return "&" + strings.Join(values, "&")
+		}
+	})
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package hal_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+var _ = Describe("Links", func() {
+	Describe("UnmarshalJSON", func() {
+		It("should decode single links and the first of several links with the same relation", func() {
+			var links hal.Links
+			err := json.Unmarshal([]byte(`{
+				"self": {"href": "https://dataflow.example.com/"},
+				"streams/definitions/definition": {"href": "https://dataflow.example.com/streams/definitions/{name}", "templated": true},
+				"curies": [{"href": "https://first"}, {"href": "https://second"}],
+				"empty": []
+			}`), &links)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(links).To(Equal(hal.Links{
+				"self":                           {Href: "https://dataflow.example.com/"},
+				"streams/definitions/definition": {Href: "https://dataflow.example.com/streams/definitions/{name}", Templated: true},
+				"curies":                         {Href: "https://first"},
+			}))
+		})
+
+		It("should reject invalid links", func() {
+			var links hal.Links
+			Expect(json.Unmarshal([]byte(`{"self": "https://dataflow.example.com/"}`), &links)).NotTo(Succeed())
+		})
+	})
+
+	Describe("Href", func() {
+		links := hal.Links{
+			"about":  {Href: "https://dataflow.example.com/about"},
+			"stream": {Href: "https://dataflow.example.com/streams/definitions/{name}", Templated: true},
+		}
+
+		It("should return plain links unchanged", func() {
+			href, ok := links.Href("about", map[string]string{"name": "ignored"})
+			Expect(ok).To(BeTrue())
+			Expect(href).To(Equal("https://dataflow.example.com/about"))
+		})
+
+		It("should expand templated links", func() {
+			href, ok := links.Href("stream", map[string]string{"name": "tick tock"})
+			Expect(ok).To(BeTrue())
+			Expect(href).To(Equal("https://dataflow.example.com/streams/definitions/tick%20tock"))
+		})
+
+		It("should report missing links", func() {
+			_, ok := links.Href("missing", nil)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("ExpandTemplate", func() {
+		params := map[string]string{
+			"name": "ticktock",
+			"page": "2",
+			"size": "20",
+			"path": "a/b",
+			"sort": "name,DESC",
+		}
+
+		It("should expand simple expressions", func() {
+			Expect(hal.ExpandTemplate("/streams/definitions/{name}", params)).To(Equal("/streams/definitions/ticktock"))
+		})
+
+		It("should expand simple with escaping expressions", func() {
+			Expect(hal.ExpandTemplate("/files/{path}", params)).To(Equal("/files/a%2Fb"))
+		})
+
+		It("should expand reserved expressions", func() {
+			Expect(hal.ExpandTemplate("/files/{+path}", params)).To(Equal("/files/a/b"))
+		})
+
+		It("should expand query expressions", func() {
+			Expect(hal.ExpandTemplate("/streams/definitions{?page,size,sort}", params)).To(Equal("/streams/definitions?page=2&size=20&sort=name%2CDESC"))
+		})
+
+		It("should expand query with undefined variables expressions", func() {
+			Expect(hal.ExpandTemplate("/streams/definitions{?search,page}", params)).To(Equal("/streams/definitions?page=2"))
+		})
+
+		It("should expand query with no defined variables expressions", func() {
+			Expect(hal.ExpandTemplate("/streams/definitions{?search}", params)).To(Equal("/streams/definitions"))
+		})
+
+		It("should expand query continuation expressions", func() {
+			Expect(hal.ExpandTemplate("/tasks/executions?task=x{&page}", params)).To(Equal("/tasks/executions?task=x&page=2"))
+		})
+
+		It("should expand path segments expressions", func() {
+			Expect(hal.ExpandTemplate("/streams{/name,page}", params)).To(Equal("/streams/ticktock/2"))
+		})
+
+		It("should expand label expressions", func() {
+			Expect(hal.ExpandTemplate("/file{.size}", params)).To(Equal("/file.20"))
+		})
+
+		It("should expand fragment expressions", func() {
+			Expect(hal.ExpandTemplate("/doc{#path}", params)).To(Equal("/doc#a/b"))
+		})
+
+		It("should expand path-style expressions", func() {
+			Expect(hal.ExpandTemplate("/x{;name}", params)).To(Equal("/x;name=ticktock"))
+		})
+
+		It("should expand modifiers expressions", func() {
+			Expect(hal.ExpandTemplate("/x/{name:3}{?page*}", params)).To(Equal("/x/ticktock?page=2"))
+		})
+	})
+})
@@ -17,105 +17,205 @@
 package httpclient
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"sync"
 )
 
 //go:generate counterfeiter -o httpclientfakes/fake_authenticated_client.go . AuthenticatedClient
 type AuthenticatedClient interface {
+	DoAuthenticated(ctx context.Context, request *Request, accessToken string) (*http.Response, error)
+
 	DoAuthenticatedGet(url string, accessToken string) (io.ReadCloser, int, http.Header, error)
 
+	DoAuthenticatedGetContext(ctx context.Context, url string, accessToken string) (io.ReadCloser, int, http.Header, error)
+
 	DoAuthenticatedDelete(url string, accessToken string) (int, error)
 
+	DoAuthenticatedDeleteContext(ctx context.Context, url string, accessToken string) (int, error)
+
 	DoAuthenticatedPost(url string, bodyType string, body string, accessToken string) (io.ReadCloser, int, error)
 
+	DoAuthenticatedPostContext(ctx context.Context, url string, bodyType string, body string, accessToken string) (io.ReadCloser, int, error)
+
 	DoAuthenticatedPut(url string, accessToken string) (int, error)
+
+	DoAuthenticatedPutContext(ctx context.Context, url string, accessToken string) (int, error)
 }
 
 type authenticatedClient struct {
-	Httpclient Client
+	Httpclient  Client
+	tokenSource TokenSource
+
+	// refreshedTokens maps access tokens which have been rejected to the tokens which replaced them.
+	refreshedTokens map[string]string
+	tokenMutex      sync.Mutex
 }
 
 func NewAuthenticatedClient(httpClient Client) *authenticatedClient {
 	return &authenticatedClient{Httpclient: httpClient}
 }
 
-func (c *authenticatedClient) DoAuthenticatedGet(url string, accessToken string) (io.ReadCloser, int, http.Header, error) {
-	statusCode := 0
-	req, err := http.NewRequest("GET", url, nil)
+// NewRefreshingAuthenticatedClient returns an AuthenticatedClient which, when a request is rejected with status
+// 401 Unauthorized, refreshes the access token using the given token source and replays the request once.
+// Subsequent requests made with the rejected access token use the refreshed token instead.
+func NewRefreshingAuthenticatedClient(httpClient Client, tokenSource TokenSource) *authenticatedClient {
+	return &authenticatedClient{
+		Httpclient:      httpClient,
+		tokenSource:     tokenSource,
+		refreshedTokens: map[string]string{},
+	}
+}
+
+// DoAuthenticated sends the given request using the given access token. If the response status is not accepted by
+// the request, an error wrapping a ServerError is returned together with the response, whose body has been consumed.
+func (c *authenticatedClient) DoAuthenticated(ctx context.Context, request *Request, accessToken string) (*http.Response, error) {
+	req, err := request.httpRequest(ctx)
 	if err != nil {
-		return nil, statusCode, map[string][]string{}, fmt.Errorf("Request creation error: %s", err)
+		return nil, fmt.Errorf("Request creation error: %s", err)
 	}
 
-	req.Header.Add("Accept", "application/json")
-	addAuthorizationHeader(req, accessToken)
-	resp, err := c.Httpclient.Do(req)
+	resp, err := c.do(req, accessToken)
 	if err != nil {
-		return nil, 0, map[string][]string{}, fmt.Errorf("Authenticated get of '%s' failed: %s", url, err)
+		return nil, fmt.Errorf("Authenticated %s failed: %w", request.description(), err)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, resp.StatusCode, resp.Header, fmt.Errorf("Authenticated get of '%s' failed: %s", url, resp.Status)
+	if !request.accepts(resp.StatusCode) {
+		return resp, fmt.Errorf("Authenticated %s failed: %w", request.description(), NewServerError(resp))
 	}
+	return resp, nil
+}
 
-	return resp.Body, resp.StatusCode, resp.Header, nil
+func (c *authenticatedClient) DoAuthenticatedGet(url string, accessToken string) (io.ReadCloser, int, http.Header, error) {
+	return c.DoAuthenticatedGetContext(context.Background(), url, accessToken)
 }
 
-func (c *authenticatedClient) DoAuthenticatedDelete(url string, accessToken string) (int, error) {
-	req, err := http.NewRequest("DELETE", url, nil)
+// DoAuthenticatedGetContext is like DoAuthenticatedGet but the request is cancelled if the given context is done.
+func (c *authenticatedClient) DoAuthenticatedGetContext(ctx context.Context, url string, accessToken string) (io.ReadCloser, int, http.Header, error) {
+	resp, err := c.DoAuthenticated(ctx, NewRequest(http.MethodGet, url).WithHeader("Accept", ContentTypeJSON), accessToken)
 	if err != nil {
-		return 0, fmt.Errorf("Request creation error: %s", err)
+		if resp == nil {
+			return nil, 0, map[string][]string{}, err
+		}
+		return nil, resp.StatusCode, resp.Header, err
 	}
+	return resp.Body, resp.StatusCode, resp.Header, nil
+}
 
-	req.Header.Add("Accept", "application/json")
-	addAuthorizationHeader(req, accessToken)
-	resp, err := c.Httpclient.Do(req)
-	if err != nil {
-		return 0, fmt.Errorf("Authenticated delete of '%s' failed: %s", url, err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return resp.StatusCode, fmt.Errorf("Authenticated delete of '%s' failed: %s", url, resp.Status)
-	}
-	return resp.StatusCode, nil
+func (c *authenticatedClient) DoAuthenticatedDelete(url string, accessToken string) (int, error) {
+	return c.DoAuthenticatedDeleteContext(context.Background(), url, accessToken)
+}
+
+// DoAuthenticatedDeleteContext is like DoAuthenticatedDelete but the request is cancelled if the given context is done.
+func (c *authenticatedClient) DoAuthenticatedDeleteContext(ctx context.Context, url string, accessToken string) (int, error) {
+	return statusOnly(c.DoAuthenticated(ctx, NewRequest(http.MethodDelete, url).WithHeader("Accept", ContentTypeJSON), accessToken))
 }
 
 func (c *authenticatedClient) DoAuthenticatedPost(url string, bodyType string, bodyStr string, accessToken string) (io.ReadCloser, int, error) {
-	body := strings.NewReader(bodyStr)
-	req, err := http.NewRequest("POST", url, body)
+	return c.DoAuthenticatedPostContext(context.Background(), url, bodyType, bodyStr, accessToken)
+}
+
+// DoAuthenticatedPostContext is like DoAuthenticatedPost but the request is cancelled if the given context is done.
+func (c *authenticatedClient) DoAuthenticatedPostContext(ctx context.Context, url string, bodyType string, bodyStr string, accessToken string) (io.ReadCloser, int, error) {
+	resp, err := c.DoAuthenticated(ctx, NewRequest(http.MethodPost, url).WithBody(bodyType, bodyStr), accessToken)
 	if err != nil {
-		return nil, 0, fmt.Errorf("Request creation error: %s", err)
+		if resp == nil {
+			return nil, 0, err
+		}
+		return nil, resp.StatusCode, err
 	}
-	addAuthorizationHeader(req, accessToken)
-	req.Header.Set("Content-Type", bodyType)
+	return resp.Body, resp.StatusCode, nil
+}
+
+func (c *authenticatedClient) DoAuthenticatedPut(url string, accessToken string) (int, error) {
+	return c.DoAuthenticatedPutContext(context.Background(), url, accessToken)
+}
+
+// DoAuthenticatedPutContext is like DoAuthenticatedPut but the request is cancelled if the given context is done.
+func (c *authenticatedClient) DoAuthenticatedPutContext(ctx context.Context, url string, accessToken string) (int, error) {
+	return statusOnly(c.DoAuthenticated(ctx, NewRequest(http.MethodPut, url), accessToken))
+}
+
+// statusOnly discards the body of a response, returning its status code.
+func statusOnly(resp *http.Response, err error) (int, error) {
+	if resp == nil {
+		return 0, err
+	}
+	closeBody(resp)
+	return resp.StatusCode, err
+}
+
+// do sends the given request with the given access token. If the request is rejected as unauthorized and the access
+// token can be refreshed, the request is replayed once with the refreshed token.
+func (c *authenticatedClient) do(req *http.Request, accessToken string) (*http.Response, error) {
+	accessToken = c.currentToken(accessToken)
+	setAuthorizationHeader(req, accessToken)
 	resp, err := c.Httpclient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.tokenSource == nil {
+		return resp, err
+	}
+
+	refreshedToken, err := c.refreshToken(accessToken)
 	if err != nil {
-		return nil, 0, fmt.Errorf("Authenticated post to '%s' failed: %s", url, err)
+		closeBody(resp)
+		return nil, fmt.Errorf("access token refresh failed: %s", err)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, resp.StatusCode, fmt.Errorf("Authenticated post to '%s' failed: %s", url, resp.Status)
+	if refreshedToken == accessToken {
+		return resp, nil
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	closeBody(resp)
+	setAuthorizationHeader(retry, refreshedToken)
+	return c.Httpclient.Do(retry)
 }
 
-func (c *authenticatedClient) DoAuthenticatedPut(url string, accessToken string) (int, error) {
-	req, err := http.NewRequest("PUT", url, nil)
-	if err != nil {
-		return 0, fmt.Errorf("Request creation error: %s", err)
+func (c *authenticatedClient) currentToken(accessToken string) string {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	for i := 0; i < len(c.refreshedTokens); i++ {
+		refreshedToken, ok := c.refreshedTokens[accessToken]
+		if !ok {
+			break
+		}
+		accessToken = refreshedToken
+	}
+	return accessToken
+}
+
+// refreshToken returns a replacement for the given rejected access token. Concurrent requests rejected with the same
+// access token share a single refresh.
+func (c *authenticatedClient) refreshToken(accessToken string) (string, error) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	if refreshedToken, ok := c.refreshedTokens[accessToken]; ok {
+		return refreshedToken, nil
 	}
 
-	addAuthorizationHeader(req, accessToken)
-	resp, err := c.Httpclient.Do(req)
+	refreshedToken, err := c.tokenSource.Refresh()
 	if err != nil {
-		return 0, fmt.Errorf("Authenticated put of '%s' failed: %s", url, err)
+		return "", err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return resp.StatusCode, fmt.Errorf("Authenticated put of '%s' failed: %s", url, resp.Status)
+	if refreshedToken != accessToken {
+		c.refreshedTokens[accessToken] = refreshedToken
+	}
+	return refreshedToken, nil
+}
+
+func closeBody(resp *http.Response) {
+	if resp.Body != nil {
+		resp.Body.Close()
 	}
-	return resp.StatusCode, nil
 }
 
-func addAuthorizationHeader(req *http.Request, accessToken string) {
-	req.Header.Add("Authorization", fmt.Sprintf("bearer %s", accessToken))
+func setAuthorizationHeader(req *http.Request, accessToken string) {
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", accessToken))
 }
@@ -17,6 +17,7 @@
 package httpclient_test
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -340,4 +341,164 @@ var _ = Describe("Authclient", func() {
 			})
 		})
 	})
+
+	Describe("context-aware requests", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			URL = testUrl
+			ctx, cancel = context.WithCancel(context.Background())
+			resp := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}
+			fakeClient.DoReturns(resp, nil)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("should pass the context to DoAuthenticatedGetContext requests", func() {
+			_, _, _, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedGetContext(ctx, URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.DoArgsForCall(0).Context()).To(Equal(ctx))
+		})
+
+		It("should pass the context to DoAuthenticatedDeleteContext requests", func() {
+			_, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedDeleteContext(ctx, URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.DoArgsForCall(0).Context()).To(Equal(ctx))
+		})
+
+		It("should pass the context to DoAuthenticatedPostContext requests", func() {
+			_, _, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedPostContext(ctx, URL, "text/plain", "body", testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.DoArgsForCall(0).Context()).To(Equal(ctx))
+		})
+
+		It("should pass the context to DoAuthenticatedPutContext requests", func() {
+			_, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedPutContext(ctx, URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.DoArgsForCall(0).Context()).To(Equal(ctx))
+		})
+
+		Context("when the context is cancelled", func() {
+			BeforeEach(func() {
+				fakeClient.DoStub = func(req *http.Request) (*http.Response, error) {
+					return nil, req.Context().Err()
+				}
+				cancel()
+			})
+
+			It("should produce an error", func() {
+				_, _, _, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedGetContext(ctx, URL, testAccessToken)
+				Expect(err).To(MatchError("Authenticated get of 'https://eureka.pivotal.io/auth/request' failed: context canceled"))
+			})
+		})
+	})
+
+	Describe("token refresh", func() {
+		const refreshedAccessToken = "refreshed-access-token"
+
+		var (
+			fakeTokenSource *httpclientfakes.FakeTokenSource
+			authClient      httpclient.AuthenticatedClient
+			unauthorized    *http.Response
+			ok              *http.Response
+		)
+
+		BeforeEach(func() {
+			URL = testUrl
+			fakeTokenSource = &httpclientfakes.FakeTokenSource{}
+			fakeTokenSource.RefreshReturns(refreshedAccessToken, nil)
+			unauthorized = &http.Response{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized", Body: ioutil.NopCloser(strings.NewReader(""))}
+			ok = &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("response"))}
+			fakeClient.DoReturnsOnCall(0, unauthorized, nil)
+			fakeClient.DoReturnsOnCall(1, ok, nil)
+			authClient = httpclient.NewRefreshingAuthenticatedClient(fakeClient, fakeTokenSource)
+		})
+
+		It("should refresh the token and replay a rejected request", func() {
+			_, status, _, err = authClient.DoAuthenticatedGet(URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(fakeTokenSource.RefreshCallCount()).To(Equal(1))
+			Expect(fakeClient.DoCallCount()).To(Equal(2))
+			Expect(fakeClient.DoArgsForCall(0).Header.Get("Authorization")).To(Equal(testBearerAccessToken))
+			Expect(fakeClient.DoArgsForCall(1).Header.Get("Authorization")).To(Equal("bearer " + refreshedAccessToken))
+			Expect(fakeClient.DoArgsForCall(1).Header.Get("Accept")).To(Equal("application/json"))
+		})
+
+		It("should replay the body of a rejected post", func() {
+			_, status, err = authClient.DoAuthenticatedPost(URL, "text/plain", "body", testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.DoCallCount()).To(Equal(2))
+			bodyContents, readErr := ioutil.ReadAll(fakeClient.DoArgsForCall(1).Body)
+			Expect(readErr).NotTo(HaveOccurred())
+			Expect(string(bodyContents)).To(Equal("body"))
+			Expect(fakeClient.DoArgsForCall(1).Header.Get("Content-Type")).To(Equal("text/plain"))
+		})
+
+		It("should use the refreshed token for subsequent requests made with the rejected token", func() {
+			_, _, _, err = authClient.DoAuthenticatedGet(URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			fakeClient.DoReturnsOnCall(2, ok, nil)
+
+			_, err = authClient.DoAuthenticatedDelete(URL, testAccessToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeTokenSource.RefreshCallCount()).To(Equal(1))
+			Expect(fakeClient.DoCallCount()).To(Equal(3))
+			Expect(fakeClient.DoArgsForCall(2).Header.Get("Authorization")).To(Equal("bearer " + refreshedAccessToken))
+		})
+
+		Context("when the replayed request is also rejected", func() {
+			BeforeEach(func() {
+				fakeClient.DoReturnsOnCall(1, unauthorized, nil)
+			})
+
+			It("should not replay the request again", func() {
+				_, status, _, err = authClient.DoAuthenticatedGet(URL, testAccessToken)
+				Expect(err).To(MatchError("Authenticated get of 'https://eureka.pivotal.io/auth/request' failed: 401 Unauthorized"))
+				Expect(status).To(Equal(http.StatusUnauthorized))
+				Expect(fakeClient.DoCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the token cannot be refreshed", func() {
+			BeforeEach(func() {
+				fakeTokenSource.RefreshReturns("", testErr)
+			})
+
+			It("should return a suitable error", func() {
+				_, _, _, err = authClient.DoAuthenticatedGet(URL, testAccessToken)
+				Expect(err).To(MatchError(fmt.Sprintf("Authenticated get of 'https://eureka.pivotal.io/auth/request' failed: access token refresh failed: %s", errMessage)))
+				Expect(fakeClient.DoCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the refreshed token is unchanged", func() {
+			BeforeEach(func() {
+				fakeTokenSource.RefreshReturns(testAccessToken, nil)
+			})
+
+			It("should not replay the request", func() {
+				status, err = authClient.DoAuthenticatedPut(URL, testAccessToken)
+				Expect(err).To(MatchError("Authenticated put of 'https://eureka.pivotal.io/auth/request' failed: 401 Unauthorized"))
+				Expect(fakeClient.DoCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the client has no token source", func() {
+			BeforeEach(func() {
+				authClient = httpclient.NewAuthenticatedClient(fakeClient)
+			})
+
+			It("should not replay a rejected request", func() {
+				_, status, _, err = authClient.DoAuthenticatedGet(URL, testAccessToken)
+				Expect(status).To(Equal(http.StatusUnauthorized))
+				Expect(fakeClient.DoCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
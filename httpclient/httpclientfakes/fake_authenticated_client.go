@@ -2,6 +2,7 @@
 package httpclientfakes
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"sync"
@@ -10,11 +11,55 @@ import (
 )
 
 type FakeAuthenticatedClient struct {
-	DoAuthenticatedGetStub        func(url string, accessToken string) (io.ReadCloser, int, http.Header, error)
+	DoAuthenticatedStub        func(context.Context, *httpclient.Request, string) (*http.Response, error)
+	doAuthenticatedMutex       sync.RWMutex
+	doAuthenticatedArgsForCall []struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+		arg3 string
+	}
+	doAuthenticatedReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	doAuthenticatedReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	DoAuthenticatedDeleteStub        func(string, string) (int, error)
+	doAuthenticatedDeleteMutex       sync.RWMutex
+	doAuthenticatedDeleteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	doAuthenticatedDeleteReturns struct {
+		result1 int
+		result2 error
+	}
+	doAuthenticatedDeleteReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	DoAuthenticatedDeleteContextStub        func(context.Context, string, string) (int, error)
+	doAuthenticatedDeleteContextMutex       sync.RWMutex
+	doAuthenticatedDeleteContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	doAuthenticatedDeleteContextReturns struct {
+		result1 int
+		result2 error
+	}
+	doAuthenticatedDeleteContextReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	DoAuthenticatedGetStub        func(string, string) (io.ReadCloser, int, http.Header, error)
 	doAuthenticatedGetMutex       sync.RWMutex
 	doAuthenticatedGetArgsForCall []struct {
-		url         string
-		accessToken string
+		arg1 string
+		arg2 string
 	}
 	doAuthenticatedGetReturns struct {
 		result1 io.ReadCloser
@@ -28,27 +73,32 @@ type FakeAuthenticatedClient struct {
 		result3 http.Header
 		result4 error
 	}
-	DoAuthenticatedDeleteStub        func(url string, accessToken string) (int, error)
-	doAuthenticatedDeleteMutex       sync.RWMutex
-	doAuthenticatedDeleteArgsForCall []struct {
-		url         string
-		accessToken string
+	DoAuthenticatedGetContextStub        func(context.Context, string, string) (io.ReadCloser, int, http.Header, error)
+	doAuthenticatedGetContextMutex       sync.RWMutex
+	doAuthenticatedGetContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
 	}
-	doAuthenticatedDeleteReturns struct {
-		result1 int
-		result2 error
+	doAuthenticatedGetContextReturns struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 http.Header
+		result4 error
 	}
-	doAuthenticatedDeleteReturnsOnCall map[int]struct {
-		result1 int
-		result2 error
+	doAuthenticatedGetContextReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 http.Header
+		result4 error
 	}
-	DoAuthenticatedPostStub        func(url string, bodyType string, body string, accessToken string) (io.ReadCloser, int, error)
+	DoAuthenticatedPostStub        func(string, string, string, string) (io.ReadCloser, int, error)
 	doAuthenticatedPostMutex       sync.RWMutex
 	doAuthenticatedPostArgsForCall []struct {
-		url         string
-		bodyType    string
-		body        string
-		accessToken string
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
 	}
 	doAuthenticatedPostReturns struct {
 		result1 io.ReadCloser
@@ -60,11 +110,30 @@ type FakeAuthenticatedClient struct {
 		result2 int
 		result3 error
 	}
-	DoAuthenticatedPutStub        func(url string, accessToken string) (int, error)
+	DoAuthenticatedPostContextStub        func(context.Context, string, string, string, string) (io.ReadCloser, int, error)
+	doAuthenticatedPostContextMutex       sync.RWMutex
+	doAuthenticatedPostContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	doAuthenticatedPostContextReturns struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 error
+	}
+	doAuthenticatedPostContextReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 error
+	}
+	DoAuthenticatedPutStub        func(string, string) (int, error)
 	doAuthenticatedPutMutex       sync.RWMutex
 	doAuthenticatedPutArgsForCall []struct {
-		url         string
-		accessToken string
+		arg1 string
+		arg2 string
 	}
 	doAuthenticatedPutReturns struct {
 		result1 int
@@ -74,26 +143,240 @@ type FakeAuthenticatedClient struct {
 		result1 int
 		result2 error
 	}
+	DoAuthenticatedPutContextStub        func(context.Context, string, string) (int, error)
+	doAuthenticatedPutContextMutex       sync.RWMutex
+	doAuthenticatedPutContextArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	doAuthenticatedPutContextReturns struct {
+		result1 int
+		result2 error
+	}
+	doAuthenticatedPutContextReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedGet(url string, accessToken string) (io.ReadCloser, int, http.Header, error) {
+func (fake *FakeAuthenticatedClient) DoAuthenticated(arg1 context.Context, arg2 *httpclient.Request, arg3 string) (*http.Response, error) {
+	fake.doAuthenticatedMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedReturnsOnCall[len(fake.doAuthenticatedArgsForCall)]
+	fake.doAuthenticatedArgsForCall = append(fake.doAuthenticatedArgsForCall, struct {
+		arg1 context.Context
+		arg2 *httpclient.Request
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DoAuthenticatedStub
+	fakeReturns := fake.doAuthenticatedReturns
+	fake.recordInvocation("DoAuthenticated", []interface{}{arg1, arg2, arg3})
+	fake.doAuthenticatedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedCallCount() int {
+	fake.doAuthenticatedMutex.RLock()
+	defer fake.doAuthenticatedMutex.RUnlock()
+	return len(fake.doAuthenticatedArgsForCall)
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedCalls(stub func(context.Context, *httpclient.Request, string) (*http.Response, error)) {
+	fake.doAuthenticatedMutex.Lock()
+	defer fake.doAuthenticatedMutex.Unlock()
+	fake.DoAuthenticatedStub = stub
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedArgsForCall(i int) (context.Context, *httpclient.Request, string) {
+	fake.doAuthenticatedMutex.RLock()
+	defer fake.doAuthenticatedMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedReturns(result1 *http.Response, result2 error) {
+	fake.doAuthenticatedMutex.Lock()
+	defer fake.doAuthenticatedMutex.Unlock()
+	fake.DoAuthenticatedStub = nil
+	fake.doAuthenticatedReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.doAuthenticatedMutex.Lock()
+	defer fake.doAuthenticatedMutex.Unlock()
+	fake.DoAuthenticatedStub = nil
+	if fake.doAuthenticatedReturnsOnCall == nil {
+		fake.doAuthenticatedReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.doAuthenticatedReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDelete(arg1 string, arg2 string) (int, error) {
+	fake.doAuthenticatedDeleteMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedDeleteReturnsOnCall[len(fake.doAuthenticatedDeleteArgsForCall)]
+	fake.doAuthenticatedDeleteArgsForCall = append(fake.doAuthenticatedDeleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DoAuthenticatedDeleteStub
+	fakeReturns := fake.doAuthenticatedDeleteReturns
+	fake.recordInvocation("DoAuthenticatedDelete", []interface{}{arg1, arg2})
+	fake.doAuthenticatedDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteCallCount() int {
+	fake.doAuthenticatedDeleteMutex.RLock()
+	defer fake.doAuthenticatedDeleteMutex.RUnlock()
+	return len(fake.doAuthenticatedDeleteArgsForCall)
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteCalls(stub func(string, string) (int, error)) {
+	fake.doAuthenticatedDeleteMutex.Lock()
+	defer fake.doAuthenticatedDeleteMutex.Unlock()
+	fake.DoAuthenticatedDeleteStub = stub
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteArgsForCall(i int) (string, string) {
+	fake.doAuthenticatedDeleteMutex.RLock()
+	defer fake.doAuthenticatedDeleteMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteReturns(result1 int, result2 error) {
+	fake.doAuthenticatedDeleteMutex.Lock()
+	defer fake.doAuthenticatedDeleteMutex.Unlock()
+	fake.DoAuthenticatedDeleteStub = nil
+	fake.doAuthenticatedDeleteReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doAuthenticatedDeleteMutex.Lock()
+	defer fake.doAuthenticatedDeleteMutex.Unlock()
+	fake.DoAuthenticatedDeleteStub = nil
+	if fake.doAuthenticatedDeleteReturnsOnCall == nil {
+		fake.doAuthenticatedDeleteReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doAuthenticatedDeleteReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContext(arg1 context.Context, arg2 string, arg3 string) (int, error) {
+	fake.doAuthenticatedDeleteContextMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedDeleteContextReturnsOnCall[len(fake.doAuthenticatedDeleteContextArgsForCall)]
+	fake.doAuthenticatedDeleteContextArgsForCall = append(fake.doAuthenticatedDeleteContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DoAuthenticatedDeleteContextStub
+	fakeReturns := fake.doAuthenticatedDeleteContextReturns
+	fake.recordInvocation("DoAuthenticatedDeleteContext", []interface{}{arg1, arg2, arg3})
+	fake.doAuthenticatedDeleteContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContextCallCount() int {
+	fake.doAuthenticatedDeleteContextMutex.RLock()
+	defer fake.doAuthenticatedDeleteContextMutex.RUnlock()
+	return len(fake.doAuthenticatedDeleteContextArgsForCall)
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContextCalls(stub func(context.Context, string, string) (int, error)) {
+	fake.doAuthenticatedDeleteContextMutex.Lock()
+	defer fake.doAuthenticatedDeleteContextMutex.Unlock()
+	fake.DoAuthenticatedDeleteContextStub = stub
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContextArgsForCall(i int) (context.Context, string, string) {
+	fake.doAuthenticatedDeleteContextMutex.RLock()
+	defer fake.doAuthenticatedDeleteContextMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedDeleteContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContextReturns(result1 int, result2 error) {
+	fake.doAuthenticatedDeleteContextMutex.Lock()
+	defer fake.doAuthenticatedDeleteContextMutex.Unlock()
+	fake.DoAuthenticatedDeleteContextStub = nil
+	fake.doAuthenticatedDeleteContextReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteContextReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doAuthenticatedDeleteContextMutex.Lock()
+	defer fake.doAuthenticatedDeleteContextMutex.Unlock()
+	fake.DoAuthenticatedDeleteContextStub = nil
+	if fake.doAuthenticatedDeleteContextReturnsOnCall == nil {
+		fake.doAuthenticatedDeleteContextReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doAuthenticatedDeleteContextReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGet(arg1 string, arg2 string) (io.ReadCloser, int, http.Header, error) {
 	fake.doAuthenticatedGetMutex.Lock()
 	ret, specificReturn := fake.doAuthenticatedGetReturnsOnCall[len(fake.doAuthenticatedGetArgsForCall)]
 	fake.doAuthenticatedGetArgsForCall = append(fake.doAuthenticatedGetArgsForCall, struct {
-		url         string
-		accessToken string
-	}{url, accessToken})
-	fake.recordInvocation("DoAuthenticatedGet", []interface{}{url, accessToken})
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DoAuthenticatedGetStub
+	fakeReturns := fake.doAuthenticatedGetReturns
+	fake.recordInvocation("DoAuthenticatedGet", []interface{}{arg1, arg2})
 	fake.doAuthenticatedGetMutex.Unlock()
-	if fake.DoAuthenticatedGetStub != nil {
-		return fake.DoAuthenticatedGetStub(url, accessToken)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4
 	}
-	return fake.doAuthenticatedGetReturns.result1, fake.doAuthenticatedGetReturns.result2, fake.doAuthenticatedGetReturns.result3, fake.doAuthenticatedGetReturns.result4
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedGetCallCount() int {
@@ -102,13 +385,22 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedGetCallCount() int {
 	return len(fake.doAuthenticatedGetArgsForCall)
 }
 
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetCalls(stub func(string, string) (io.ReadCloser, int, http.Header, error)) {
+	fake.doAuthenticatedGetMutex.Lock()
+	defer fake.doAuthenticatedGetMutex.Unlock()
+	fake.DoAuthenticatedGetStub = stub
+}
+
 func (fake *FakeAuthenticatedClient) DoAuthenticatedGetArgsForCall(i int) (string, string) {
 	fake.doAuthenticatedGetMutex.RLock()
 	defer fake.doAuthenticatedGetMutex.RUnlock()
-	return fake.doAuthenticatedGetArgsForCall[i].url, fake.doAuthenticatedGetArgsForCall[i].accessToken
+	argsForCall := fake.doAuthenticatedGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedGetReturns(result1 io.ReadCloser, result2 int, result3 http.Header, result4 error) {
+	fake.doAuthenticatedGetMutex.Lock()
+	defer fake.doAuthenticatedGetMutex.Unlock()
 	fake.DoAuthenticatedGetStub = nil
 	fake.doAuthenticatedGetReturns = struct {
 		result1 io.ReadCloser
@@ -119,6 +411,8 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedGetReturns(result1 io.ReadCl
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedGetReturnsOnCall(i int, result1 io.ReadCloser, result2 int, result3 http.Header, result4 error) {
+	fake.doAuthenticatedGetMutex.Lock()
+	defer fake.doAuthenticatedGetMutex.Unlock()
 	fake.DoAuthenticatedGetStub = nil
 	if fake.doAuthenticatedGetReturnsOnCall == nil {
 		fake.doAuthenticatedGetReturnsOnCall = make(map[int]struct {
@@ -136,76 +430,98 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedGetReturnsOnCall(i int, resu
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedDelete(url string, accessToken string) (int, error) {
-	fake.doAuthenticatedDeleteMutex.Lock()
-	ret, specificReturn := fake.doAuthenticatedDeleteReturnsOnCall[len(fake.doAuthenticatedDeleteArgsForCall)]
-	fake.doAuthenticatedDeleteArgsForCall = append(fake.doAuthenticatedDeleteArgsForCall, struct {
-		url         string
-		accessToken string
-	}{url, accessToken})
-	fake.recordInvocation("DoAuthenticatedDelete", []interface{}{url, accessToken})
-	fake.doAuthenticatedDeleteMutex.Unlock()
-	if fake.DoAuthenticatedDeleteStub != nil {
-		return fake.DoAuthenticatedDeleteStub(url, accessToken)
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContext(arg1 context.Context, arg2 string, arg3 string) (io.ReadCloser, int, http.Header, error) {
+	fake.doAuthenticatedGetContextMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedGetContextReturnsOnCall[len(fake.doAuthenticatedGetContextArgsForCall)]
+	fake.doAuthenticatedGetContextArgsForCall = append(fake.doAuthenticatedGetContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DoAuthenticatedGetContextStub
+	fakeReturns := fake.doAuthenticatedGetContextReturns
+	fake.recordInvocation("DoAuthenticatedGetContext", []interface{}{arg1, arg2, arg3})
+	fake.doAuthenticatedGetContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3, ret.result4
 	}
-	return fake.doAuthenticatedDeleteReturns.result1, fake.doAuthenticatedDeleteReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteCallCount() int {
-	fake.doAuthenticatedDeleteMutex.RLock()
-	defer fake.doAuthenticatedDeleteMutex.RUnlock()
-	return len(fake.doAuthenticatedDeleteArgsForCall)
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContextCallCount() int {
+	fake.doAuthenticatedGetContextMutex.RLock()
+	defer fake.doAuthenticatedGetContextMutex.RUnlock()
+	return len(fake.doAuthenticatedGetContextArgsForCall)
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteArgsForCall(i int) (string, string) {
-	fake.doAuthenticatedDeleteMutex.RLock()
-	defer fake.doAuthenticatedDeleteMutex.RUnlock()
-	return fake.doAuthenticatedDeleteArgsForCall[i].url, fake.doAuthenticatedDeleteArgsForCall[i].accessToken
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContextCalls(stub func(context.Context, string, string) (io.ReadCloser, int, http.Header, error)) {
+	fake.doAuthenticatedGetContextMutex.Lock()
+	defer fake.doAuthenticatedGetContextMutex.Unlock()
+	fake.DoAuthenticatedGetContextStub = stub
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteReturns(result1 int, result2 error) {
-	fake.DoAuthenticatedDeleteStub = nil
-	fake.doAuthenticatedDeleteReturns = struct {
-		result1 int
-		result2 error
-	}{result1, result2}
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContextArgsForCall(i int) (context.Context, string, string) {
+	fake.doAuthenticatedGetContextMutex.RLock()
+	defer fake.doAuthenticatedGetContextMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedGetContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedDeleteReturnsOnCall(i int, result1 int, result2 error) {
-	fake.DoAuthenticatedDeleteStub = nil
-	if fake.doAuthenticatedDeleteReturnsOnCall == nil {
-		fake.doAuthenticatedDeleteReturnsOnCall = make(map[int]struct {
-			result1 int
-			result2 error
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContextReturns(result1 io.ReadCloser, result2 int, result3 http.Header, result4 error) {
+	fake.doAuthenticatedGetContextMutex.Lock()
+	defer fake.doAuthenticatedGetContextMutex.Unlock()
+	fake.DoAuthenticatedGetContextStub = nil
+	fake.doAuthenticatedGetContextReturns = struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 http.Header
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedGetContextReturnsOnCall(i int, result1 io.ReadCloser, result2 int, result3 http.Header, result4 error) {
+	fake.doAuthenticatedGetContextMutex.Lock()
+	defer fake.doAuthenticatedGetContextMutex.Unlock()
+	fake.DoAuthenticatedGetContextStub = nil
+	if fake.doAuthenticatedGetContextReturnsOnCall == nil {
+		fake.doAuthenticatedGetContextReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 int
+			result3 http.Header
+			result4 error
 		})
 	}
-	fake.doAuthenticatedDeleteReturnsOnCall[i] = struct {
-		result1 int
-		result2 error
-	}{result1, result2}
+	fake.doAuthenticatedGetContextReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 http.Header
+		result4 error
+	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedPost(url string, bodyType string, body string, accessToken string) (io.ReadCloser, int, error) {
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPost(arg1 string, arg2 string, arg3 string, arg4 string) (io.ReadCloser, int, error) {
 	fake.doAuthenticatedPostMutex.Lock()
 	ret, specificReturn := fake.doAuthenticatedPostReturnsOnCall[len(fake.doAuthenticatedPostArgsForCall)]
 	fake.doAuthenticatedPostArgsForCall = append(fake.doAuthenticatedPostArgsForCall, struct {
-		url         string
-		bodyType    string
-		body        string
-		accessToken string
-	}{url, bodyType, body, accessToken})
-	fake.recordInvocation("DoAuthenticatedPost", []interface{}{url, bodyType, body, accessToken})
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DoAuthenticatedPostStub
+	fakeReturns := fake.doAuthenticatedPostReturns
+	fake.recordInvocation("DoAuthenticatedPost", []interface{}{arg1, arg2, arg3, arg4})
 	fake.doAuthenticatedPostMutex.Unlock()
-	if fake.DoAuthenticatedPostStub != nil {
-		return fake.DoAuthenticatedPostStub(url, bodyType, body, accessToken)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.doAuthenticatedPostReturns.result1, fake.doAuthenticatedPostReturns.result2, fake.doAuthenticatedPostReturns.result3
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPostCallCount() int {
@@ -214,13 +530,22 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPostCallCount() int {
 	return len(fake.doAuthenticatedPostArgsForCall)
 }
 
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostCalls(stub func(string, string, string, string) (io.ReadCloser, int, error)) {
+	fake.doAuthenticatedPostMutex.Lock()
+	defer fake.doAuthenticatedPostMutex.Unlock()
+	fake.DoAuthenticatedPostStub = stub
+}
+
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPostArgsForCall(i int) (string, string, string, string) {
 	fake.doAuthenticatedPostMutex.RLock()
 	defer fake.doAuthenticatedPostMutex.RUnlock()
-	return fake.doAuthenticatedPostArgsForCall[i].url, fake.doAuthenticatedPostArgsForCall[i].bodyType, fake.doAuthenticatedPostArgsForCall[i].body, fake.doAuthenticatedPostArgsForCall[i].accessToken
+	argsForCall := fake.doAuthenticatedPostArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPostReturns(result1 io.ReadCloser, result2 int, result3 error) {
+	fake.doAuthenticatedPostMutex.Lock()
+	defer fake.doAuthenticatedPostMutex.Unlock()
 	fake.DoAuthenticatedPostStub = nil
 	fake.doAuthenticatedPostReturns = struct {
 		result1 io.ReadCloser
@@ -230,6 +555,8 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPostReturns(result1 io.ReadC
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPostReturnsOnCall(i int, result1 io.ReadCloser, result2 int, result3 error) {
+	fake.doAuthenticatedPostMutex.Lock()
+	defer fake.doAuthenticatedPostMutex.Unlock()
 	fake.DoAuthenticatedPostStub = nil
 	if fake.doAuthenticatedPostReturnsOnCall == nil {
 		fake.doAuthenticatedPostReturnsOnCall = make(map[int]struct {
@@ -245,22 +572,95 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPostReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeAuthenticatedClient) DoAuthenticatedPut(url string, accessToken string) (int, error) {
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContext(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string) (io.ReadCloser, int, error) {
+	fake.doAuthenticatedPostContextMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedPostContextReturnsOnCall[len(fake.doAuthenticatedPostContextArgsForCall)]
+	fake.doAuthenticatedPostContextArgsForCall = append(fake.doAuthenticatedPostContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DoAuthenticatedPostContextStub
+	fakeReturns := fake.doAuthenticatedPostContextReturns
+	fake.recordInvocation("DoAuthenticatedPostContext", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.doAuthenticatedPostContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContextCallCount() int {
+	fake.doAuthenticatedPostContextMutex.RLock()
+	defer fake.doAuthenticatedPostContextMutex.RUnlock()
+	return len(fake.doAuthenticatedPostContextArgsForCall)
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContextCalls(stub func(context.Context, string, string, string, string) (io.ReadCloser, int, error)) {
+	fake.doAuthenticatedPostContextMutex.Lock()
+	defer fake.doAuthenticatedPostContextMutex.Unlock()
+	fake.DoAuthenticatedPostContextStub = stub
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContextArgsForCall(i int) (context.Context, string, string, string, string) {
+	fake.doAuthenticatedPostContextMutex.RLock()
+	defer fake.doAuthenticatedPostContextMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedPostContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContextReturns(result1 io.ReadCloser, result2 int, result3 error) {
+	fake.doAuthenticatedPostContextMutex.Lock()
+	defer fake.doAuthenticatedPostContextMutex.Unlock()
+	fake.DoAuthenticatedPostContextStub = nil
+	fake.doAuthenticatedPostContextReturns = struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPostContextReturnsOnCall(i int, result1 io.ReadCloser, result2 int, result3 error) {
+	fake.doAuthenticatedPostContextMutex.Lock()
+	defer fake.doAuthenticatedPostContextMutex.Unlock()
+	fake.DoAuthenticatedPostContextStub = nil
+	if fake.doAuthenticatedPostContextReturnsOnCall == nil {
+		fake.doAuthenticatedPostContextReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 int
+			result3 error
+		})
+	}
+	fake.doAuthenticatedPostContextReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPut(arg1 string, arg2 string) (int, error) {
 	fake.doAuthenticatedPutMutex.Lock()
 	ret, specificReturn := fake.doAuthenticatedPutReturnsOnCall[len(fake.doAuthenticatedPutArgsForCall)]
 	fake.doAuthenticatedPutArgsForCall = append(fake.doAuthenticatedPutArgsForCall, struct {
-		url         string
-		accessToken string
-	}{url, accessToken})
-	fake.recordInvocation("DoAuthenticatedPut", []interface{}{url, accessToken})
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DoAuthenticatedPutStub
+	fakeReturns := fake.doAuthenticatedPutReturns
+	fake.recordInvocation("DoAuthenticatedPut", []interface{}{arg1, arg2})
 	fake.doAuthenticatedPutMutex.Unlock()
-	if fake.DoAuthenticatedPutStub != nil {
-		return fake.DoAuthenticatedPutStub(url, accessToken)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.doAuthenticatedPutReturns.result1, fake.doAuthenticatedPutReturns.result2
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPutCallCount() int {
@@ -269,13 +669,22 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPutCallCount() int {
 	return len(fake.doAuthenticatedPutArgsForCall)
 }
 
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutCalls(stub func(string, string) (int, error)) {
+	fake.doAuthenticatedPutMutex.Lock()
+	defer fake.doAuthenticatedPutMutex.Unlock()
+	fake.DoAuthenticatedPutStub = stub
+}
+
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPutArgsForCall(i int) (string, string) {
 	fake.doAuthenticatedPutMutex.RLock()
 	defer fake.doAuthenticatedPutMutex.RUnlock()
-	return fake.doAuthenticatedPutArgsForCall[i].url, fake.doAuthenticatedPutArgsForCall[i].accessToken
+	argsForCall := fake.doAuthenticatedPutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPutReturns(result1 int, result2 error) {
+	fake.doAuthenticatedPutMutex.Lock()
+	defer fake.doAuthenticatedPutMutex.Unlock()
 	fake.DoAuthenticatedPutStub = nil
 	fake.doAuthenticatedPutReturns = struct {
 		result1 int
@@ -284,6 +693,8 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPutReturns(result1 int, resu
 }
 
 func (fake *FakeAuthenticatedClient) DoAuthenticatedPutReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doAuthenticatedPutMutex.Lock()
+	defer fake.doAuthenticatedPutMutex.Unlock()
 	fake.DoAuthenticatedPutStub = nil
 	if fake.doAuthenticatedPutReturnsOnCall == nil {
 		fake.doAuthenticatedPutReturnsOnCall = make(map[int]struct {
@@ -297,17 +708,93 @@ func (fake *FakeAuthenticatedClient) DoAuthenticatedPutReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContext(arg1 context.Context, arg2 string, arg3 string) (int, error) {
+	fake.doAuthenticatedPutContextMutex.Lock()
+	ret, specificReturn := fake.doAuthenticatedPutContextReturnsOnCall[len(fake.doAuthenticatedPutContextArgsForCall)]
+	fake.doAuthenticatedPutContextArgsForCall = append(fake.doAuthenticatedPutContextArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DoAuthenticatedPutContextStub
+	fakeReturns := fake.doAuthenticatedPutContextReturns
+	fake.recordInvocation("DoAuthenticatedPutContext", []interface{}{arg1, arg2, arg3})
+	fake.doAuthenticatedPutContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContextCallCount() int {
+	fake.doAuthenticatedPutContextMutex.RLock()
+	defer fake.doAuthenticatedPutContextMutex.RUnlock()
+	return len(fake.doAuthenticatedPutContextArgsForCall)
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContextCalls(stub func(context.Context, string, string) (int, error)) {
+	fake.doAuthenticatedPutContextMutex.Lock()
+	defer fake.doAuthenticatedPutContextMutex.Unlock()
+	fake.DoAuthenticatedPutContextStub = stub
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContextArgsForCall(i int) (context.Context, string, string) {
+	fake.doAuthenticatedPutContextMutex.RLock()
+	defer fake.doAuthenticatedPutContextMutex.RUnlock()
+	argsForCall := fake.doAuthenticatedPutContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContextReturns(result1 int, result2 error) {
+	fake.doAuthenticatedPutContextMutex.Lock()
+	defer fake.doAuthenticatedPutContextMutex.Unlock()
+	fake.DoAuthenticatedPutContextStub = nil
+	fake.doAuthenticatedPutContextReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthenticatedClient) DoAuthenticatedPutContextReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doAuthenticatedPutContextMutex.Lock()
+	defer fake.doAuthenticatedPutContextMutex.Unlock()
+	fake.DoAuthenticatedPutContextStub = nil
+	if fake.doAuthenticatedPutContextReturnsOnCall == nil {
+		fake.doAuthenticatedPutContextReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doAuthenticatedPutContextReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthenticatedClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.doAuthenticatedGetMutex.RLock()
-	defer fake.doAuthenticatedGetMutex.RUnlock()
+	fake.doAuthenticatedMutex.RLock()
+	defer fake.doAuthenticatedMutex.RUnlock()
 	fake.doAuthenticatedDeleteMutex.RLock()
 	defer fake.doAuthenticatedDeleteMutex.RUnlock()
+	fake.doAuthenticatedDeleteContextMutex.RLock()
+	defer fake.doAuthenticatedDeleteContextMutex.RUnlock()
+	fake.doAuthenticatedGetMutex.RLock()
+	defer fake.doAuthenticatedGetMutex.RUnlock()
+	fake.doAuthenticatedGetContextMutex.RLock()
+	defer fake.doAuthenticatedGetContextMutex.RUnlock()
 	fake.doAuthenticatedPostMutex.RLock()
 	defer fake.doAuthenticatedPostMutex.RUnlock()
+	fake.doAuthenticatedPostContextMutex.RLock()
+	defer fake.doAuthenticatedPostContextMutex.RUnlock()
 	fake.doAuthenticatedPutMutex.RLock()
 	defer fake.doAuthenticatedPutMutex.RUnlock()
+	fake.doAuthenticatedPutContextMutex.RLock()
+	defer fake.doAuthenticatedPutContextMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,192 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeForm = "application/x-www-form-urlencoded"
+)
+
+// Request describes an authenticated request. Create a Request using NewRequest and configure it using its With and
+// Accepting methods, which return the Request so that calls may be chained. A Request accepts only status 200 OK
+// unless configured otherwise.
+type Request struct {
+	method       string
+	url          string
+	query        url.Values
+	header       http.Header
+	body         string
+	hasBody      bool
+	bodyErr      error
+	accepted     map[int]bool
+	acceptAny2xx bool
+}
+
+// NewRequest returns a request with the given method, such as http.MethodPatch, and URL.
+func NewRequest(method string, url string) *Request {
+	return &Request{
+		method:   method,
+		url:      url,
+		query:    map[string][]string{},
+		header:   map[string][]string{},
+		accepted: map[int]bool{http.StatusOK: true},
+	}
+}
+
+// WithQuery adds a query parameter to the request URL.
+func (r *Request) WithQuery(name string, value string) *Request {
+	r.query.Add(name, value)
+	return r
+}
+
+// WithHeader adds a header to the request.
+func (r *Request) WithHeader(name string, value string) *Request {
+	r.header.Add(name, value)
+	return r
+}
+
+// WithBody sets the request body and its content type.
+func (r *Request) WithBody(contentType string, body string) *Request {
+	r.header.Set("Content-Type", contentType)
+	r.body = body
+	r.hasBody = true
+	return r
+}
+
+// WithJSONBody sets the request body to the JSON encoding of the given value. An encoding error is reported when the
+// request is sent.
+func (r *Request) WithJSONBody(value interface{}) *Request {
+	body, err := json.Marshal(value)
+	if err != nil {
+		r.bodyErr = err
+		return r
+	}
+	return r.WithBody(ContentTypeJSON, string(body))
+}
+
+// WithFormBody sets the request body to the given form values.
+func (r *Request) WithFormBody(values url.Values) *Request {
+	return r.WithBody(ContentTypeForm, values.Encode())
+}
+
+// Accepting sets the status codes which indicate that the request succeeded, replacing any previously accepted
+// status codes.
+func (r *Request) Accepting(statusCodes ...int) *Request {
+	r.accepted = map[int]bool{}
+	for _, statusCode := range statusCodes {
+		r.accepted[statusCode] = true
+	}
+	r.acceptAny2xx = false
+	return r
+}
+
+// AcceptingAnySuccess accepts any 2xx status code, such as 201 Created, 202 Accepted, or 204 No Content.
+func (r *Request) AcceptingAnySuccess() *Request {
+	r.accepted = map[int]bool{}
+	r.acceptAny2xx = true
+	return r
+}
+
+// Clone returns a copy of the request which may be modified without affecting the original.
+func (r *Request) Clone() *Request {
+	clone := *r
+	clone.query = url.Values{}
+	for name, values := range r.query {
+		clone.query[name] = append([]string(nil), values...)
+	}
+	clone.header = r.header.Clone()
+	clone.accepted = map[int]bool{}
+	for statusCode, accepted := range r.accepted {
+		clone.accepted[statusCode] = accepted
+	}
+	return &clone
+}
+
+// Method returns the request method.
+func (r *Request) Method() string {
+	return r.method
+}
+
+// URL returns the request URL including any query parameters.
+func (r *Request) URL() string {
+	if len(r.query) == 0 {
+		return r.url
+	}
+	separator := "?"
+	if strings.Contains(r.url, "?") {
+		separator = "&"
+	}
+	return r.url + separator + r.query.Encode()
+}
+
+// Body returns the request body, or the empty string if the request has no body.
+func (r *Request) Body() string {
+	return r.body
+}
+
+// Header returns the value of the given request header.
+func (r *Request) Header(name string) string {
+	return r.header.Get(name)
+}
+
+func (r *Request) accepts(statusCode int) bool {
+	if r.acceptAny2xx {
+		return statusCode >= 200 && statusCode < 300
+	}
+	return r.accepted[statusCode]
+}
+
+func (r *Request) httpRequest(ctx context.Context) (*http.Request, error) {
+	if r.bodyErr != nil {
+		return nil, r.bodyErr
+	}
+
+	var req *http.Request
+	var err error
+	if r.hasBody {
+		req, err = http.NewRequestWithContext(ctx, r.method, r.URL(), strings.NewReader(r.body))
+	} else {
+		req, err = http.NewRequestWithContext(ctx, r.method, r.URL(), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range r.header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	return req, nil
+}
+
+// description describes the request in error messages, for example "get of 'https://...'".
+func (r *Request) description() string {
+	preposition := "of"
+	if r.method == http.MethodPost {
+		preposition = "to"
+	}
+	return strings.ToLower(r.method) + " " + preposition + " '" + r.URL() + "'"
+}
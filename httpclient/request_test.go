@@ -0,0 +1,189 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+)
+
+var _ = Describe("DoAuthenticated", func() {
+	const (
+		testUrl         = "https://dataflow.example.com/streams/deployments/ticktock"
+		testAccessToken = "access-token"
+	)
+
+	var (
+		fakeClient *httpclientfakes.FakeClient
+		request    *httpclient.Request
+		resp       *http.Response
+		err        error
+	)
+
+	BeforeEach(func() {
+		fakeClient = &httpclientfakes.FakeClient{}
+		fakeClient.DoReturns(&http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: ioutil.NopCloser(strings.NewReader("response"))}, nil)
+		request = httpclient.NewRequest(http.MethodGet, testUrl)
+	})
+
+	JustBeforeEach(func() {
+		resp, err = httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticated(context.Background(), request, testAccessToken)
+	})
+
+	sentRequest := func() *http.Request {
+		Expect(fakeClient.DoCallCount()).To(Equal(1))
+		return fakeClient.DoArgsForCall(0)
+	}
+
+	sentBody := func() string {
+		body, readErr := ioutil.ReadAll(sentRequest().Body)
+		Expect(readErr).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	It("should send the request with the access token", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sentRequest().Method).To(Equal(http.MethodGet))
+		Expect(sentRequest().URL.String()).To(Equal(testUrl))
+		Expect(sentRequest().Header.Get("Authorization")).To(Equal("bearer " + testAccessToken))
+	})
+
+	It("should return the response", func() {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		Expect(readErr).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal("response"))
+	})
+
+	Context("when query parameters and headers are specified", func() {
+		BeforeEach(func() {
+			request = httpclient.NewRequest(http.MethodGet, testUrl+"?reuse-deployment-properties=true").
+				WithQuery("page", "1").
+				WithQuery("size", "20").
+				WithHeader("Accept", "application/hal+json")
+		})
+
+		It("should send them", func() {
+			Expect(sentRequest().URL.Query()).To(Equal(url.Values{
+				"reuse-deployment-properties": []string{"true"},
+				"page":                        []string{"1"},
+				"size":                        []string{"20"},
+			}))
+			Expect(sentRequest().Header.Get("Accept")).To(Equal("application/hal+json"))
+		})
+	})
+
+	Context("when a JSON body is specified", func() {
+		BeforeEach(func() {
+			request = httpclient.NewRequest(http.MethodPatch, testUrl).WithJSONBody(map[string]string{"app.time.count": "2"})
+		})
+
+		It("should send the encoded body", func() {
+			Expect(sentRequest().Method).To(Equal(http.MethodPatch))
+			Expect(sentRequest().Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(sentBody()).To(Equal(`{"app.time.count":"2"}`))
+		})
+	})
+
+	Context("when the JSON body cannot be encoded", func() {
+		BeforeEach(func() {
+			request = httpclient.NewRequest(http.MethodPost, testUrl).WithJSONBody(func() {})
+		})
+
+		It("should return a suitable error without sending the request", func() {
+			Expect(err).To(MatchError("Request creation error: json: unsupported type: func()"))
+			Expect(fakeClient.DoCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a form body is specified", func() {
+		BeforeEach(func() {
+			request = httpclient.NewRequest(http.MethodPut, testUrl).WithFormBody(url.Values{"name": []string{"ticktock"}, "definition": []string{"time | log"}})
+		})
+
+		It("should send the encoded body", func() {
+			Expect(sentRequest().Method).To(Equal(http.MethodPut))
+			Expect(sentRequest().Header.Get("Content-Type")).To(Equal("application/x-www-form-urlencoded"))
+			Expect(sentBody()).To(Equal("definition=time+%7C+log&name=ticktock"))
+		})
+	})
+
+	Context("when the server responds with 201 Created", func() {
+		BeforeEach(func() {
+			fakeClient.DoReturns(&http.Response{StatusCode: http.StatusCreated, Status: "201 Created"}, nil)
+		})
+
+		It("should fail by default", func() {
+			Expect(err).To(MatchError("Authenticated get of '" + testUrl + "' failed: 201 Created"))
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+		})
+
+		Context("when the request accepts 201 Created", func() {
+			BeforeEach(func() {
+				request.Accepting(http.StatusCreated, http.StatusAccepted)
+			})
+
+			It("should succeed", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			})
+		})
+
+		Context("when the request accepts any success", func() {
+			BeforeEach(func() {
+				request.AcceptingAnySuccess()
+			})
+
+			It("should succeed", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Context("when the request accepts any success and the server responds with a redirect", func() {
+		BeforeEach(func() {
+			request.AcceptingAnySuccess()
+			fakeClient.DoReturns(&http.Response{StatusCode: http.StatusFound, Status: "302 Found"}, nil)
+		})
+
+		It("should fail", func() {
+			var serverError *httpclient.ServerError
+			Expect(errors.As(err, &serverError)).To(BeTrue())
+			Expect(serverError.StatusCode).To(Equal(http.StatusFound))
+		})
+	})
+
+	Context("when a post fails", func() {
+		BeforeEach(func() {
+			request = httpclient.NewRequest(http.MethodPost, testUrl)
+			fakeClient.DoReturns(nil, errors.New("connection refused"))
+		})
+
+		It("should describe the request in the error", func() {
+			Expect(err).To(MatchError("Authenticated post to '" + testUrl + "' failed: connection refused"))
+			Expect(resp).To(BeNil())
+		})
+	})
+})
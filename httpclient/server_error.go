@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodyLength bounds the amount of an error response body which is read when decoding it.
+const maxErrorBodyLength = 64 * 1024
+
+// VndError is an error reported by a Spring Cloud Data Flow or Skipper server in the application/vnd.error format.
+type VndError struct {
+	Logref  string `json:"logref"`
+	Message string `json:"message"`
+}
+
+// ServerError is returned, wrapped, when a server responds with an unexpected status code. Errors holds any errors
+// described by the response body. Use errors.As to obtain a ServerError from an error returned by this package.
+type ServerError struct {
+	StatusCode int
+	Status     string
+	Errors     []VndError
+}
+
+// Error returns the response status so that the server's message, which may be long, can be reported separately.
+func (e *ServerError) Error() string {
+	return e.Status
+}
+
+// Message returns the messages of the errors described by the server, separated by semicolons, or the empty string
+// if the server did not describe the error.
+func (e *ServerError) Message() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, vndError := range e.Errors {
+		if vndError.Message != "" {
+			messages = append(messages, vndError.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Logrefs returns the log references of the errors described by the server.
+func (e *ServerError) Logrefs() []string {
+	logrefs := []string{}
+	for _, vndError := range e.Errors {
+		if vndError.Logref != "" {
+			logrefs = append(logrefs, vndError.Logref)
+		}
+	}
+	return logrefs
+}
+
+// NewServerError returns a ServerError for the given response, decoding any errors described by the response body.
+// The response body is consumed and closed.
+func NewServerError(resp *http.Response) *ServerError {
+	serverError := &ServerError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+	}
+	if resp.Body == nil {
+		return serverError
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	if err != nil {
+		return serverError
+	}
+	serverError.Errors = decodeVndErrors(body)
+	return serverError
+}
+
+// decodeVndErrors decodes a single VndError, an array of VndErrors, or a HAL resource with VndErrors embedded as
+// "errors". Nil is returned if the body is not in any of these forms.
+func decodeVndErrors(body []byte) []VndError {
+	var embedded struct {
+		Embedded struct {
+			Errors []VndError `json:"errors"`
+		} `json:"_embedded"`
+	}
+	if err := json.Unmarshal(body, &embedded); err == nil && len(embedded.Embedded.Errors) > 0 {
+		return embedded.Embedded.Errors
+	}
+
+	var vndErrors []VndError
+	if err := json.Unmarshal(body, &vndErrors); err == nil && len(vndErrors) > 0 {
+		return vndErrors
+	}
+
+	var vndError VndError
+	if err := json.Unmarshal(body, &vndError); err == nil && (vndError.Logref != "" || vndError.Message != "") {
+		return []VndError{vndError}
+	}
+
+	return nil
+}
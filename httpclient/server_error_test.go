@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+)
+
+var _ = Describe("ServerError", func() {
+	var (
+		body        string
+		serverError *httpclient.ServerError
+	)
+
+	JustBeforeEach(func() {
+		serverError = httpclient.NewServerError(&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Status:     "400 Bad Request",
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		})
+	})
+
+	Context("when the body is a single VndError", func() {
+		BeforeEach(func() {
+			body = `{"logref":"NoSuchStreamDefinitionException","message":"Could not find stream definition named ticktock"}`
+		})
+
+		It("should decode the error", func() {
+			Expect(serverError.StatusCode).To(Equal(http.StatusBadRequest))
+			Expect(serverError.Errors).To(Equal([]httpclient.VndError{{Logref: "NoSuchStreamDefinitionException", Message: "Could not find stream definition named ticktock"}}))
+			Expect(serverError.Message()).To(Equal("Could not find stream definition named ticktock"))
+			Expect(serverError.Logrefs()).To(Equal([]string{"NoSuchStreamDefinitionException"}))
+			Expect(serverError.Error()).To(Equal("400 Bad Request"))
+		})
+	})
+
+	Context("when the body is an array of VndErrors", func() {
+		BeforeEach(func() {
+			body = `[{"logref":"A","message":"first"},{"logref":"B","message":"second"}]`
+		})
+
+		It("should decode the errors", func() {
+			Expect(serverError.Message()).To(Equal("first; second"))
+			Expect(serverError.Logrefs()).To(Equal([]string{"A", "B"}))
+		})
+	})
+
+	Context("when the body embeds errors", func() {
+		BeforeEach(func() {
+			body = `{"_embedded":{"errors":[{"logref":"DuplicateStreamDefinitionException","message":"Cannot create stream ticktock because another one has already been created with the same name"}]}}`
+		})
+
+		It("should decode the errors", func() {
+			Expect(serverError.Message()).To(Equal("Cannot create stream ticktock because another one has already been created with the same name"))
+			Expect(serverError.Logrefs()).To(Equal([]string{"DuplicateStreamDefinitionException"}))
+		})
+	})
+
+	Context("when the body does not describe an error", func() {
+		BeforeEach(func() {
+			body = "<html>Bad gateway</html>"
+		})
+
+		It("should report the status only", func() {
+			Expect(serverError.Errors).To(BeEmpty())
+			Expect(serverError.Message()).To(BeEmpty())
+			Expect(serverError.Error()).To(Equal("400 Bad Request"))
+		})
+	})
+
+	Context("when returned by an authenticated client", func() {
+		BeforeEach(func() {
+			body = `{"logref":"NoSuchStreamDefinitionException","message":"Could not find stream definition named ticktock"}`
+		})
+
+		It("should be available using errors.As", func() {
+			fakeClient := &httpclientfakes.FakeClient{}
+			fakeClient.DoReturns(&http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil)
+
+			_, _, _, err := httpclient.NewAuthenticatedClient(fakeClient).DoAuthenticatedGet("https://dataflow.example.com/streams/definitions/ticktock", "token")
+			Expect(err).To(MatchError("Authenticated get of 'https://dataflow.example.com/streams/definitions/ticktock' failed: 404 Not Found"))
+
+			var returnedError *httpclient.ServerError
+			Expect(errors.As(err, &returnedError)).To(BeTrue())
+			Expect(returnedError.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(returnedError.Message()).To(Equal("Could not find stream definition named ticktock"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+)
+
+const (
+	ConnectTimeoutEnvVar = "SCDF_CONNECT_TIMEOUT"
+	RequestTimeoutEnvVar = "SCDF_REQUEST_TIMEOUT"
+	IdleTimeoutEnvVar    = "SCDF_IDLE_TIMEOUT"
+
+	defaultConnectTimeout = 30 * time.Second
+	defaultRequestTimeout = 2 * time.Minute
+	defaultIdleTimeout    = 90 * time.Second
+)
+
+// Timeouts configures an HTTP client. Connect bounds establishing a connection, including the TLS handshake.
+// Overall bounds a complete request, including reading the response body. Idle bounds how long an unused connection
+// is kept open for reuse. A zero value means no timeout.
+type Timeouts struct {
+	Connect time.Duration
+	Overall time.Duration
+	Idle    time.Duration
+}
+
+// DefaultTimeouts returns the timeouts used when none are configured.
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		Connect: defaultConnectTimeout,
+		Overall: defaultRequestTimeout,
+		Idle:    defaultIdleTimeout,
+	}
+}
+
+// TimeoutsFromEnvironment returns the default timeouts overridden by any of the SCDF_CONNECT_TIMEOUT,
+// SCDF_REQUEST_TIMEOUT, and SCDF_IDLE_TIMEOUT environment variables. Values are either durations, such as "90s" or
+// "5m", or whole numbers of seconds.
+func TimeoutsFromEnvironment() (Timeouts, error) {
+	timeouts := DefaultTimeouts()
+	for _, setting := range []struct {
+		envVar  string
+		timeout *time.Duration
+	}{
+		{ConnectTimeoutEnvVar, &timeouts.Connect},
+		{RequestTimeoutEnvVar, &timeouts.Overall},
+		{IdleTimeoutEnvVar, &timeouts.Idle},
+	} {
+		timeout, err := cli.DurationFromEnvironment(setting.envVar, *setting.timeout, "90s")
+		if err != nil {
+			return Timeouts{}, err
+		}
+		*setting.timeout = timeout
+	}
+	return timeouts, nil
+}
+
+// NewHttpClient returns an HTTP client with the given timeouts which does not follow redirects.
+func NewHttpClient(skipSslValidation bool, timeouts Timeouts) *http.Client {
+	return NewTracingHttpClient(skipSslValidation, timeouts, nil)
+}
+
+// NewTracingHttpClient is like NewHttpClient but, unless the given trace writer is nil, the client traces its
+// requests and responses to the trace writer.
+func NewTracingHttpClient(skipSslValidation bool, timeouts Timeouts, traceWriter io.Writer) *http.Client {
+	var tr http.RoundTripper = &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: skipSslValidation},
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   timeouts.Connect,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: timeouts.Connect,
+		IdleConnTimeout:     timeouts.Idle,
+	}
+	if traceWriter != nil {
+		tr = NewTracingTransport(tr, traceWriter)
+	}
+	return &http.Client{
+		Transport: tr,
+		Timeout:   timeouts.Overall,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse // avoid following redirects
+		},
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient_test
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+var _ = Describe("Timeouts", func() {
+	var (
+		envVars  = []string{httpclient.ConnectTimeoutEnvVar, httpclient.RequestTimeoutEnvVar, httpclient.IdleTimeoutEnvVar}
+		saved    map[string]string
+		timeouts httpclient.Timeouts
+		err      error
+	)
+
+	BeforeEach(func() {
+		saved = map[string]string{}
+		for _, envVar := range envVars {
+			if value, set := os.LookupEnv(envVar); set {
+				saved[envVar] = value
+			}
+			os.Unsetenv(envVar)
+		}
+	})
+
+	AfterEach(func() {
+		for _, envVar := range envVars {
+			if value, set := saved[envVar]; set {
+				os.Setenv(envVar, value)
+			} else {
+				os.Unsetenv(envVar)
+			}
+		}
+	})
+
+	Describe("TimeoutsFromEnvironment", func() {
+		JustBeforeEach(func() {
+			timeouts, err = httpclient.TimeoutsFromEnvironment()
+		})
+
+		Context("when no environment variables are set", func() {
+			It("should return the default timeouts", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timeouts).To(Equal(httpclient.DefaultTimeouts()))
+			})
+		})
+
+		Context("when environment variables are set", func() {
+			BeforeEach(func() {
+				os.Setenv(httpclient.ConnectTimeoutEnvVar, "5s")
+				os.Setenv(httpclient.RequestTimeoutEnvVar, "600")
+				os.Setenv(httpclient.IdleTimeoutEnvVar, "0")
+			})
+
+			It("should override the default timeouts", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timeouts).To(Equal(httpclient.Timeouts{
+					Connect: 5 * time.Second,
+					Overall: 10 * time.Minute,
+					Idle:    0,
+				}))
+			})
+		})
+
+		Context("when an environment variable is invalid", func() {
+			BeforeEach(func() {
+				os.Setenv(httpclient.RequestTimeoutEnvVar, "-1m")
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("Invalid value '-1m' of SCDF_REQUEST_TIMEOUT: expected a duration such as 90s or a number of seconds"))
+			})
+		})
+	})
+
+	Describe("NewHttpClient", func() {
+		var client *http.Client
+
+		BeforeEach(func() {
+			client = httpclient.NewHttpClient(true, httpclient.Timeouts{
+				Connect: time.Second,
+				Overall: time.Minute,
+				Idle:    time.Hour,
+			})
+		})
+
+		It("should apply the timeouts", func() {
+			Expect(client.Timeout).To(Equal(time.Minute))
+			transport := client.Transport.(*http.Transport)
+			Expect(transport.TLSHandshakeTimeout).To(Equal(time.Second))
+			Expect(transport.IdleConnTimeout).To(Equal(time.Hour))
+		})
+
+		It("should honour the SSL validation setting", func() {
+			Expect(client.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify).To(BeTrue())
+		})
+
+		It("should not follow redirects", func() {
+			Expect(client.CheckRedirect(nil, nil)).To(Equal(http.ErrUseLastResponse))
+		})
+	})
+})
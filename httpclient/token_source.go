@@ -0,0 +1,28 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient
+
+// TokenSource provides the access tokens used to authenticate requests.
+//go:generate counterfeiter -o httpclientfakes/fake_token_source.go . TokenSource
+type TokenSource interface {
+	// Token returns the current access token.
+	Token() (string, error)
+
+	// Refresh obtains a new access token. The returned token is the same as the current token if the token
+	// source is unable to refresh it.
+	Refresh() (string, error)
+}
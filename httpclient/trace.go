@@ -0,0 +1,326 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package httpclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TraceEnvVar = "CF_TRACE"
+
+	// RedactedValue replaces private data in trace output.
+	RedactedValue = "[PRIVATE DATA HIDDEN]"
+
+	// maxTraceBodyLength is the number of bytes of a request or response body which are traced.
+	maxTraceBodyLength = 4096
+
+	traceTimeFormat = time.RFC3339
+)
+
+var (
+	redactedHeaders = map[string]bool{
+		"Authorization":       true,
+		"Proxy-Authorization": true,
+		"Cookie":              true,
+		"Set-Cookie":          true,
+	}
+
+	secretName = `[^"=&]*(?:password|passwd|secret|token|credential|api[-_]?key|access[-_]?key|private[-_]?key)[^"=&]*`
+
+	secretJsonKeyPattern = regexp.MustCompile(`(?i)"` + secretName + `"\s*:\s*`)
+	secretFormPattern    = regexp.MustCompile(`(?i)((?:^|&)` + secretName + `=)[^&]*`)
+	bearerTokenPattern   = regexp.MustCompile(`(?i)(bearer\s+)[-\w.~+/]+=*`)
+	textContentSubtypes  = []string{"json", "xml", "x-www-form-urlencoded", "javascript", "yaml"}
+)
+
+// TraceWriterFromEnvironment returns the writer for HTTP trace output selected by the CF_TRACE environment
+// variable, as used by the cf CLI. "true" selects standard output and any other value apart from "false" is the path
+// of a file to which trace output is appended. A nil writer is returned if tracing is disabled.
+func TraceWriterFromEnvironment() (io.Writer, error) {
+	value := strings.TrimSpace(os.Getenv(TraceEnvVar))
+	switch strings.ToLower(value) {
+	case "", "false":
+		return nil, nil
+	case "true":
+		return os.Stdout, nil
+	}
+
+	traceWriter, err := os.OpenFile(value, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open trace file '%s' named by %s: %s", value, TraceEnvVar, err)
+	}
+	return traceWriter, nil
+}
+
+type tracingTransport struct {
+	transport   http.RoundTripper
+	traceWriter io.Writer
+	now         func() time.Time
+	mutex       *sync.Mutex
+}
+
+// NewTracingTransport returns a transport which writes each request and response made using the given transport to
+// the given writer. Authorization headers, bearer tokens, and secret-looking query parameters and JSON or form fields
+// are redacted and bodies are truncated.
+func NewTracingTransport(transport http.RoundTripper, traceWriter io.Writer) *tracingTransport {
+	return &tracingTransport{
+		transport:   transport,
+		traceWriter: traceWriter,
+		now:         time.Now,
+		mutex:       &sync.Mutex{},
+	}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.now()
+
+	var trace bytes.Buffer
+	fmt.Fprintf(&trace, "REQUEST: [%s]\n", start.Format(traceTimeFormat))
+	fmt.Fprintf(&trace, "%s %s %s\n", req.Method, redactURL(req.URL), req.Proto)
+	fmt.Fprintf(&trace, "Host: %s\n", req.Host)
+	writeHeaders(&trace, req.Header)
+	writeRequestBody(&trace, req)
+	t.write(trace.Bytes())
+
+	resp, err := t.transport.RoundTrip(req)
+	elapsed := t.now().Sub(start)
+
+	trace.Reset()
+	fmt.Fprintf(&trace, "RESPONSE: [%s] (%s)\n", t.now().Format(traceTimeFormat), elapsed.Round(time.Millisecond))
+	if err != nil {
+		fmt.Fprintf(&trace, "Error: %s\n\n", err)
+		t.write(trace.Bytes())
+		return resp, err
+	}
+	fmt.Fprintf(&trace, "%s %s\n", resp.Proto, resp.Status)
+	writeHeaders(&trace, resp.Header)
+	writeResponseBody(&trace, resp)
+	t.write(trace.Bytes())
+
+	return resp, nil
+}
+
+// write emits a complete trace entry so that entries for concurrent requests are not interleaved.
+func (t *tracingTransport) write(entry []byte) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.traceWriter.Write(entry)
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	if redacted.User != nil {
+		redacted.User = url.User(redacted.User.Username())
+	}
+	if redacted.RawQuery != "" {
+		redacted.RawQuery = redactForm(redacted.RawQuery)
+	}
+	return redacted.String()
+}
+
+func writeHeaders(trace io.Writer, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			if redactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = RedactedValue
+			}
+			fmt.Fprintf(trace, "%s: %s\n", name, value)
+		}
+	}
+}
+
+func writeRequestBody(trace io.Writer, req *http.Request) {
+	if req.Body == nil || req.Body == http.NoBody {
+		fmt.Fprintln(trace)
+		return
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	if !isText(contentType) {
+		writeBinaryBody(trace, contentType, req.ContentLength)
+		return
+	}
+
+	// Read a copy of the body, if possible, so that the request is sent unchanged.
+	body := req.Body
+	if req.GetBody != nil {
+		if copy, err := req.GetBody(); err == nil {
+			body = copy
+			defer copy.Close()
+		}
+	}
+	prefix, err := ioutil.ReadAll(io.LimitReader(body, maxTraceBodyLength+1))
+	if body == req.Body {
+		req.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), req.Body), req.Body}
+	}
+	if err != nil {
+		fmt.Fprintf(trace, "[Unable to read body: %s]\n\n", err)
+		return
+	}
+	writeTextBody(trace, contentType, prefix)
+}
+
+func writeResponseBody(trace io.Writer, resp *http.Response) {
+	if resp.Body == nil || resp.Body == http.NoBody || resp.ContentLength == 0 {
+		fmt.Fprintln(trace)
+		return
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isText(contentType) {
+		writeBinaryBody(trace, contentType, resp.ContentLength)
+		return
+	}
+
+	// Only the traced prefix of the body is read here. The remainder is left for the caller to consume.
+	prefix, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxTraceBodyLength+1))
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+	if err != nil {
+		fmt.Fprintf(trace, "[Unable to read body: %s]\n\n", err)
+		return
+	}
+	writeTextBody(trace, contentType, prefix)
+}
+
+func writeTextBody(trace io.Writer, contentType string, body []byte) {
+	truncated := len(body) > maxTraceBodyLength
+	if truncated {
+		body = body[:maxTraceBodyLength]
+	}
+	fmt.Fprintln(trace, redactBody(contentType, string(body)))
+	if truncated {
+		fmt.Fprintf(trace, "[Body truncated after %d bytes]\n", maxTraceBodyLength)
+	}
+	fmt.Fprintln(trace)
+}
+
+func writeBinaryBody(trace io.Writer, contentType string, length int64) {
+	if length < 0 {
+		fmt.Fprintf(trace, "[%s body not shown]\n\n", contentType)
+		return
+	}
+	fmt.Fprintf(trace, "[%s body of %d bytes not shown]\n\n", contentType, length)
+}
+
+func redactBody(contentType string, body string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		body = redactForm(body)
+	} else {
+		body = redactJson(body)
+	}
+	return bearerTokenPattern.ReplaceAllString(body, "${1}"+RedactedValue)
+}
+
+// redactJson replaces the values of secret-looking JSON fields, whatever their type, so that numbers, objects, and
+// arrays are redacted as well as strings. The JSON may be truncated.
+func redactJson(body string) string {
+	var redacted strings.Builder
+	for {
+		loc := secretJsonKeyPattern.FindStringIndex(body)
+		if loc == nil {
+			redacted.WriteString(body)
+			return redacted.String()
+		}
+		redacted.WriteString(body[:loc[1]])
+		redacted.WriteString(`"` + RedactedValue + `"`)
+		body = body[loc[1]+jsonValueLength(body[loc[1]:]):]
+	}
+}
+
+// jsonValueLength returns the length of the JSON value at the start of the given text or, if the value is truncated,
+// the length of the text.
+func jsonValueLength(text string) int {
+	depth := 0
+	inString := false
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case depth == 0 && strings.ContainsRune(", \t\r\n", rune(c)):
+			return i
+		}
+	}
+	return len(text)
+}
+
+func redactForm(form string) string {
+	return secretFormPattern.ReplaceAllString(form, "${1}"+RedactedValue)
+}
+
+func isText(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+	for _, subtype := range textContentSubtypes {
+		if strings.Contains(mediaType, subtype) {
+			return true
+		}
+	}
+	return false
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
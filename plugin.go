@@ -16,11 +16,17 @@
 package main
 
 import (
-	"crypto/tls"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"hash"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"os/exec"
 
@@ -29,6 +35,7 @@ import (
 	"code.cloudfoundry.org/cli/plugin"
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cfutil"
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/commands"
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/dataflow"
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download"
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/download/cache"
@@ -39,6 +46,41 @@ import (
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
 )
 
+const (
+	jarUrlFlag   = "jar-url"
+	checksumFlag = "checksum"
+	urlFlag      = "url"
+	orgFlag      = "o"
+	spaceFlag    = "s"
+
+	allSpacesFlag      = "all-spaces"
+	timeoutFlag        = "timeout"
+	descriptionFlag    = "description"
+	deployFlag         = "deploy"
+	propertiesFlag     = "properties"
+	propertiesFileFlag = "properties-file"
+	dryRunFlag         = "dry-run"
+	changesFlag        = "changes"
+	waitFlag           = "wait"
+	cleanupFlag        = "cleanup"
+	argumentsFlag      = "arguments"
+	platformFlag       = "platform"
+	taskFlag           = "task"
+	statusFlag         = "status"
+	pageFlag           = "page"
+	pageSizeFlag       = "page-size"
+	logsFlag           = "logs"
+	completedFlag      = "completed"
+	olderThanFlag      = "older-than"
+	keepLastFlag       = "keep-last"
+	parallelismFlag    = "parallelism"
+
+	defaultPageSize    = 20
+	defaultParallelism = 4
+	defaultWaitTimeout = 10 * time.Minute
+	waitPollInterval   = 5 * time.Second
+)
+
 // Plugin version. Substitute "<major>.<minor>.<build>" at build time, e.g. using -ldflags='-X main.pluginVersion=1.2.3'
 var pluginVersion = "invalid version - plugin was not built correctly"
 
@@ -54,61 +96,467 @@ func (c *Plugin) Run(cliConnection plugin.CliConnection, args []string) {
 		})
 	}
 
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: skipSslValidation},
-		Proxy:           http.ProxyFromEnvironment,
+	timeouts, err := httpclient.TimeoutsFromEnvironment()
+	if err != nil {
+		format.Diagnose(err.Error(), os.Stderr, func() {
+			os.Exit(1)
+		})
 	}
-	client := &http.Client{
-		Transport: tr,
-		CheckRedirect: func(*http.Request, []*http.Request) error {
-			return http.ErrUseLastResponse // avoid following redirects
-		},
+
+	traceWriter, err := httpclient.TraceWriterFromEnvironment()
+	if err != nil {
+		format.Diagnose(err.Error(), os.Stderr, func() {
+			os.Exit(1)
+		})
 	}
-	authClient := httpclient.NewAuthenticatedClient(client)
+
+	serverCache, err := serviceutil.ServerCacheFromEnvironment()
+	if err != nil {
+		format.Diagnose(err.Error(), os.Stderr, func() {
+			os.Exit(1)
+		})
+	}
+
+	ctx, cancel := cli.InterruptibleContext()
+	defer cancel()
+
+	client := httpclient.NewTracingHttpClient(skipSslValidation, timeouts, traceWriter)
+	httpHelper := download.NewTracingHttpHelper(ctx, traceWriter)
+	tokenSource := cfutil.NewTokenSource(cliConnection)
+	authClient := httpclient.NewRefreshingAuthenticatedClient(client, tokenSource)
 
 	argsConsumer := cli.NewArgConsumer(args, diagnoseWithHelp)
 
 	switch args[0] {
 
 	case "dataflow-shell":
-		dataflowSIName := getDataflowServerInstanceName(argsConsumer)
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
 
-		runAction(argsConsumer, cliConnection, fmt.Sprintf("Attaching shell to dataflow service %s", format.Bold(format.Cyan(dataflowSIName))), func(progressWriter io.Writer) (string, error) {
+		runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Attaching shell to %s", target), func(progressWriter io.Writer) (string, error) {
 			argsConsumer.CheckAllConsumed()
-			accessToken, err := cfutil.GetToken(cliConnection)
+			accessToken, err := tokenSource.Token()
 			if err != nil {
 				return "", err
 			}
 
-			dataflowServer, err := serviceutil.ServiceInstanceURL(cliConnection, dataflowSIName, accessToken, authClient)
+			server, err := resolveServer(ctx, cliConnection, serverCache, target, accessToken, authClient, progressWriter)
 			if err != nil {
 				return "", err
 			}
 
-			return "", downloadAndRunShell("dataflow", func() (string, string, hash.Hash, error) {
-				return dataflow.DataflowShellDownloadUrl(dataflowServer, authClient, accessToken)
+			return "", downloadAndRunShell(ctx, httpHelper, "dataflow", func() (string, string, hash.Hash, error) {
+				about, err := server.about(ctx, authClient, accessToken)
+				if err != nil {
+					return "", "", nil, err
+				}
+				return dataflow.DataflowShellDownloadUrlFromAbout(about)
 			}, func(fileName string) *exec.Cmd {
-				return dataflow.DataflowShellCommand(fileName, dataflowServer, skipSslValidation)
+				return dataflow.DataflowShellCommand(fileName, server.DataflowUrl, skipSslValidation)
 			}, progressWriter)
 		})
 
+	case "dataflow-shell-fetch":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		jarUrl := flagSet.String(jarUrlFlag, "", "")
+		checksum := flagSet.String(checksumFlag, "", "")
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+
+		if *jarUrl != "" || *checksum != "" {
+			if *jarUrl == "" || *checksum == "" {
+				diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s and --%s must be specified together.", jarUrlFlag, checksumFlag), args[0])
+			}
+			if targetFlags.specified() {
+				diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s, -%s, and -%s cannot be specified with --%s.", urlFlag, orgFlag, spaceFlag, jarUrlFlag), args[0])
+			}
+
+			runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Fetching dataflow shell JAR from %s", format.Bold(format.Cyan(*jarUrl))), func(progressWriter io.Writer) (string, error) {
+				return fetchShell(ctx, httpHelper, func() (string, string, hash.Hash, error) {
+					hashFunc, err := download.ChecksumHashFunc(*checksum)
+					return *jarUrl, *checksum, hashFunc, err
+				}, progressWriter)
+			})
+		} else {
+			target := getServerTarget(argsConsumer, targetFlags)
+
+			runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Fetching dataflow shell JAR for %s", target), func(progressWriter io.Writer) (string, error) {
+				accessToken, err := tokenSource.Token()
+				if err != nil {
+					return "", err
+				}
+
+				server, err := resolveServer(ctx, cliConnection, serverCache, target, accessToken, authClient, progressWriter)
+				if err != nil {
+					return "", err
+				}
+
+				return fetchShell(ctx, httpHelper, func() (string, string, hash.Hash, error) {
+					about, err := server.about(ctx, authClient, accessToken)
+					if err != nil {
+						return "", "", nil, err
+					}
+					return dataflow.DataflowShellDownloadUrlFromAbout(about)
+				}, progressWriter)
+			})
+		}
+
+	case "dataflow-stream-create":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		description := flagSet.String(descriptionFlag, "", "")
+		deploy := flagSet.Bool(deployFlag, false, "")
+		propertiesFlags := addPropertiesFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+		definition := argsConsumer.Consume(target.firstArg()+1, "stream definition")
+		if propertiesFlags.specified() && !*deploy {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s and --%s require --%s.", propertiesFlag, propertiesFileFlag, deployFlag), args[0])
+		}
+
+		properties := propertiesFlags.properties()
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Creating stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.CreateStream(ctx, client, streamName, definition, *description, *deploy, properties)
+		})
+
+	case "dataflow-stream-deploy":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		propertiesFlags := addPropertiesFlags(flagSet)
+		waitFlags := addWaitFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+		waitFlags.check(flagSet)
+		properties := propertiesFlags.properties()
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Deploying stream %s on %s", format.Bold(format.Cyan(streamName)), target), waitFlags.streamCommand(ctx, streamName, func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.DeployStream(ctx, client, streamName, properties)
+		}))
+
+	case "dataflow-stream-undeploy":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Undeploying stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.UndeployStream(ctx, client, streamName)
+		})
+
+	case "dataflow-stream-destroy":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Destroying stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.DestroyStream(ctx, client, streamName)
+		})
+
+	case "dataflow-stream-update":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		propertiesFlags := addPropertiesFlags(flagSet)
+		dryRun := flagSet.Bool(dryRunFlag, false, "")
+		waitFlags := addWaitFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+		waitFlags.check(flagSet)
+		if *dryRun && *waitFlags.wait {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s and --%s cannot be used together.", dryRunFlag, waitFlag), args[0])
+		}
+		properties := propertiesFlags.properties()
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Updating stream %s on %s", format.Bold(format.Cyan(streamName)), target), waitFlags.streamUpdateCommand(ctx, streamName, properties, *dryRun))
+
+	case "dataflow-stream-rollback":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		dryRun := flagSet.Bool(dryRunFlag, false, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+		version := 0
+		if versionArg := argsConsumer.ConsumeOptional(target.firstArg() + 1); versionArg != "" {
+			if version, err = strconv.Atoi(versionArg); err != nil || version < 1 {
+				diagnoseWithHelp(fmt.Sprintf("Incorrect usage: invalid release version '%s'.", versionArg), args[0])
+			}
+		}
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Rolling back stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.RollbackStream(ctx, client, streamName, version, *dryRun)
+		})
+
+	case "dataflow-stream-scale":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		propertiesFlags := addPropertiesFlags(flagSet)
+		waitFlags := addWaitFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+		appLabel := argsConsumer.Consume(target.firstArg()+1, "application label")
+		countArg := argsConsumer.Consume(target.firstArg()+2, "instance count")
+		count, err := strconv.Atoi(countArg)
+		if err != nil || count < 0 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: invalid instance count '%s'.", countArg), args[0])
+		}
+		waitFlags.check(flagSet)
+		properties := propertiesFlags.properties()
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Scaling application %s of stream %s on %s", format.Bold(format.Cyan(appLabel)), format.Bold(format.Cyan(streamName)), target), waitFlags.command(func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.ScaleStreamApp(ctx, client, streamName, appLabel, count, properties)
+		}, func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.WaitForStreamAppScale(ctx, client, streamName, appLabel, count, *waitFlags.timeout, waitPollInterval, progressWriter)
+		}))
+
+	case "dataflow-stream-wait":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		timeout := flagSet.Duration(timeoutFlag, defaultWaitTimeout, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Waiting for stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.WaitForStream(ctx, client, streamName, *timeout, waitPollInterval, progressWriter)
+		})
+
+	case "dataflow-stream-history":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		changes := flagSet.Bool(changesFlag, false, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting history of stream %s on %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.StreamHistory(ctx, client, streamName, *changes)
+		})
+
+	case "dataflow-services":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		allSpaces := flagSet.Bool(allSpacesFlag, false, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+
+		message := "Getting dataflow services"
+		if *allSpaces {
+			message = "Getting dataflow services in all spaces"
+		}
+		runAction(ctx, argsConsumer, cliConnection, message, func(progressWriter io.Writer) (string, error) {
+			accessToken, err := tokenSource.Token()
+			if err != nil {
+				return "", err
+			}
+			return commands.DataflowServices(ctx, cliConnection, serverCache, authClient, accessToken, *allSpaces)
+		})
+
+	case "dataflow-wait":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		timeout := flagSet.Duration(timeoutFlag, defaultWaitTimeout, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+
+		runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Waiting for %s", target), func(progressWriter io.Writer) (string, error) {
+			accessToken, err := tokenSource.Token()
+			if err != nil {
+				return "", err
+			}
+			if target.url != "" {
+				return commands.DataflowServerWait(ctx, authClient, accessToken, target.url, *timeout, waitPollInterval, progressWriter)
+			}
+			return commands.DataflowWait(ctx, cliConnection, serverCache, authClient, accessToken, target.org, target.space, target.serviceInstanceName, *timeout, waitPollInterval, progressWriter)
+		})
+
+	case "dataflow-streams":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting streams of %s", target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.Streams(ctx, client)
+		})
+
+	case "dataflow-stream":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		streamName := getStreamName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting stream %s of %s", format.Bold(format.Cyan(streamName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.Stream(ctx, client, streamName)
+		})
+
+	case "dataflow-tasks":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting tasks of %s", target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.Tasks(ctx, client)
+		})
+
+	case "dataflow-task-create":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		description := flagSet.String(descriptionFlag, "", "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		taskName := getTaskName(argsConsumer, target)
+		definition := argsConsumer.Consume(target.firstArg()+1, "task definition")
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Creating task %s on %s", format.Bold(format.Cyan(taskName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.CreateTask(ctx, client, taskName, definition, *description)
+		})
+
+	case "dataflow-task-destroy":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		cleanup := flagSet.Bool(cleanupFlag, false, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		taskName := getTaskName(argsConsumer, target)
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Destroying task %s on %s", format.Bold(format.Cyan(taskName)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.DestroyTask(ctx, client, taskName, *cleanup)
+		})
+
+	case "dataflow-task-launch":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		var arguments cli.StringsFlag
+		flagSet.Var(&arguments, argumentsFlag, "")
+		propertiesFlags := addPropertiesFlags(flagSet)
+		platform := flagSet.String(platformFlag, "", "")
+		waitFlags := addWaitFlags(flagSet)
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		taskName := getTaskName(argsConsumer, target)
+		waitFlags.check(flagSet)
+		launch := dataflow.TaskLaunch{
+			Arguments:  arguments,
+			Properties: propertiesFlags.properties(),
+			Platform:   *platform,
+		}
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Launching task %s on %s", format.Bold(format.Cyan(taskName)), target), waitFlags.taskLaunchCommand(ctx, taskName, launch))
+
+	case "dataflow-task-executions":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		taskName := flagSet.String(taskFlag, "", "")
+		status := flagSet.String(statusFlag, "", "")
+		page := flagSet.Int(pageFlag, 1, "")
+		pageSize := flagSet.Int(pageSizeFlag, defaultPageSize, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		statuses := getTaskExecutionStatuses(*status, args[0])
+		if *page < 1 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s must be at least 1.", pageFlag), args[0])
+		}
+		if *pageSize < 1 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s must be at least 1.", pageSizeFlag), args[0])
+		}
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting task executions of %s", target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.TaskExecutions(ctx, client, *taskName, statuses, *page, *pageSize)
+		})
+
+	case "dataflow-task-execution":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		logs := flagSet.Bool(logsFlag, false, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		idArg := argsConsumer.Consume(target.firstArg(), "task execution ID")
+		id, err := strconv.ParseInt(idArg, 10, 64)
+		if err != nil || id < 0 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: invalid task execution ID '%s'.", idArg), args[0])
+		}
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Getting task execution %s of %s", format.Bold(format.Cyan(idArg)), target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.TaskExecution(ctx, client, id, *logs)
+		})
+
+	case "dataflow-task-cleanup":
+		flagSet := flag.NewFlagSet(args[0], flag.ContinueOnError)
+		targetFlags := addTargetFlags(flagSet)
+		taskName := flagSet.String(taskFlag, "", "")
+		completed := flagSet.Bool(completedFlag, false, "")
+		olderThan := flagSet.Int(olderThanFlag, 0, "")
+		keepLast := flagSet.Int(keepLastFlag, 0, "")
+		dryRun := flagSet.Bool(dryRunFlag, false, "")
+		parallelism := flagSet.Int(parallelismFlag, defaultParallelism, "")
+		argsConsumer = cli.NewArgConsumer(cli.ParseFlags(args, flagSet, diagnoseWithHelp), diagnoseWithHelp)
+		target := getServerTarget(argsConsumer, targetFlags)
+		if *taskName == "" && !*completed && *olderThan == 0 && *keepLast == 0 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: at least one of --%s, --%s, a non-zero --%s, and a non-zero --%s must be specified.", taskFlag, completedFlag, olderThanFlag, keepLastFlag), args[0])
+		}
+		if *olderThan < 0 || *keepLast < 0 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s and --%s must not be negative.", olderThanFlag, keepLastFlag), args[0])
+		}
+		if *parallelism < 1 {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s must be at least 1.", parallelismFlag), args[0])
+		}
+		criteria := commands.TaskCleanupCriteria{
+			TaskName:  *taskName,
+			Completed: *completed,
+			OlderThan: time.Duration(*olderThan) * 24 * time.Hour,
+			KeepLast:  *keepLast,
+		}
+
+		runDataflowCommand(ctx, argsConsumer, cliConnection, serverCache, tokenSource, authClient, target, fmt.Sprintf("Cleaning up task executions of %s", target), func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+			return commands.TaskCleanup(ctx, client, criteria, time.Now(), *dryRun, *parallelism, progressWriter)
+		})
+
+	case "dataflow-cache":
+		operation := argsConsumer.Consume(1, "cache operation")
+		bundleFile := argsConsumer.Consume(2, "cache bundle file")
+
+		switch operation {
+		case "export":
+			runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Exporting shell JAR cache to %s", format.Bold(format.Cyan(bundleFile))), func(progressWriter io.Writer) (string, error) {
+				return exportCache(bundleFile, progressWriter)
+			})
+
+		case "import":
+			runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Importing shell JAR cache from %s", format.Bold(format.Cyan(bundleFile))), func(progressWriter io.Writer) (string, error) {
+				return importCache(bundleFile, progressWriter)
+			})
+
+		default:
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: invalid cache operation '%s'.", operation), args[0])
+		}
+
 	// case "skipper-shell":
 	// 	skipperSIName := getSkipperServerInstanceName(argsConsumer)
 
-	// 	runAction(argsConsumer, cliConnection, fmt.Sprintf("Attaching Skipper shell to Skipper service %s", format.Bold(format.Cyan(skipperSIName))), func(progressWriter io.Writer) (string, error) {
+	// 	runAction(ctx, argsConsumer, cliConnection, fmt.Sprintf("Attaching Skipper shell to Skipper service %s", format.Bold(format.Cyan(skipperSIName))), func(progressWriter io.Writer) (string, error) {
 	// 		argsConsumer.CheckAllConsumed()
-	// 		accessToken, err := cfutil.GetToken(cliConnection)
+	// 		accessToken, err := tokenSource.Token()
 	// 		if err != nil {
 	// 			return "", err
 	// 		}
 
-	// 		skipperServer, err := serviceutil.ServiceInstanceURL(cliConnection, skipperSIName, accessToken, authClient)
+	// 		skipperServer, err := serviceutil.ServiceInstanceURL(ctx, cliConnection, skipperSIName, accessToken, authClient)
 	// 		if err != nil {
 	// 			return "", err
 	// 		}
 
-	// 		return "", downloadAndRunShell("Skipper", func() (string, string, hash.Hash, error) {
-	// 			return skipper.SkipperShellDownloadUrl(skipperServer, authClient, accessToken)
+	// 		return "", downloadAndRunShell(ctx, httpHelper, "Skipper", func() (string, string, hash.Hash, error) {
+	// 			return skipper.SkipperShellDownloadUrl(ctx, skipperServer, authClient, accessToken)
 	// 		}, func(fileName string) *exec.Cmd {
 	// 			return skipper.SkipperShellCommand(fileName, skipperServer, skipSslValidation)
 	// 		}, progressWriter)
@@ -119,27 +567,286 @@ func (c *Plugin) Run(cliConnection plugin.CliConnection, args []string) {
 	}
 }
 
-type urlResolver func() (string, string, hash.Hash, error)
+// serverTarget is the dataflow server targeted by a command: either the server of a service instance, which may be in
+// another org and space, or the server at a URL given by --url.
+type serverTarget struct {
+	serviceInstanceName string
+	org                 string
+	space               string
+	url                 string
+}
 
-type shellCommandFactory func(fileName string) *exec.Cmd
+func (t serverTarget) String() string {
+	if t.url != "" {
+		return fmt.Sprintf("dataflow server %s", format.Bold(format.Cyan(t.url)))
+	}
+	description := fmt.Sprintf("dataflow service %s", format.Bold(format.Cyan(t.serviceInstanceName)))
+	switch {
+	case t.org != "" && t.space != "":
+		description += fmt.Sprintf(" in org %s / space %s", format.Bold(format.Cyan(t.org)), format.Bold(format.Cyan(t.space)))
+	case t.org != "":
+		description += fmt.Sprintf(" in org %s", format.Bold(format.Cyan(t.org)))
+	case t.space != "":
+		description += fmt.Sprintf(" in space %s", format.Bold(format.Cyan(t.space)))
+	}
+	return description
+}
 
-func downloadAndRunShell(shellType string, shellDownloadUrl urlResolver, shellCommand shellCommandFactory, progressWriter io.Writer) error {
-	url, checksum, hashFunc, err := shellDownloadUrl()
+// targetFlags holds the flags which select the server targeted by a command.
+type targetFlags struct {
+	url   *string
+	org   *string
+	space *string
+}
+
+func addTargetFlags(flagSet *flag.FlagSet) *targetFlags {
+	return &targetFlags{
+		url:   flagSet.String(urlFlag, "", ""),
+		org:   flagSet.String(orgFlag, "", ""),
+		space: flagSet.String(spaceFlag, "", ""),
+	}
+}
+
+func (f *targetFlags) specified() bool {
+	return *f.url != "" || *f.org != "" || *f.space != ""
+}
+
+// getServerTarget returns the server at the URL specified using --url or, if --url was not specified, the server of
+// the service instance named by the first argument in the org and space specified using -o and -s, which default to
+// the targeted org and space.
+func getServerTarget(ac *cli.ArgConsumer, flags *targetFlags) serverTarget {
+	serverUrl := *flags.url
+	if serverUrl == "" {
+		return serverTarget{serviceInstanceName: getDataflowServerInstanceName(ac), org: *flags.org, space: *flags.space}
+	}
+	if *flags.org != "" || *flags.space != "" {
+		diagnoseWithHelp(fmt.Sprintf("Incorrect usage: -%s and -%s cannot be specified with --%s.", orgFlag, spaceFlag, urlFlag), ac.Command())
+	}
+	if parsedUrl, err := url.Parse(serverUrl); err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s must be an http or https URL.", urlFlag), ac.Command())
+	}
+	return serverTarget{url: strings.TrimSuffix(serverUrl, "/")}
+}
+
+// firstArg returns the position of the first positional argument which follows those identifying the target.
+func (t serverTarget) firstArg() int {
+	if t.url != "" {
+		return 1
+	}
+	return 2
+}
+
+// waitFlags holds the flags which make a command wait for the outcome of the operation it requests.
+type waitFlags struct {
+	wait    *bool
+	timeout *time.Duration
+}
+
+func addWaitFlags(flagSet *flag.FlagSet) *waitFlags {
+	return &waitFlags{
+		wait:    flagSet.Bool(waitFlag, false, ""),
+		timeout: flagSet.Duration(timeoutFlag, defaultWaitTimeout, ""),
+	}
+}
+
+// check diagnoses the use of --timeout without --wait.
+func (f *waitFlags) check(flagSet *flag.FlagSet) {
+	if *f.wait {
+		return
+	}
+	flagSet.Visit(func(fl *flag.Flag) {
+		if fl.Name == timeoutFlag {
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: --%s requires --%s.", timeoutFlag, waitFlag), flagSet.Name())
+		}
+	})
+}
+
+// streamCommand returns a command which runs the given command and then, if --wait was specified, waits for the
+// given stream to be deployed.
+func (f *waitFlags) streamCommand(ctx context.Context, streamName string, command dataflowCommand) dataflowCommand {
+	return f.command(command, func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		return commands.WaitForStream(ctx, client, streamName, *f.timeout, waitPollInterval, progressWriter)
+	})
+}
+
+// streamUpdateCommand returns a command which updates the given stream with the given properties, unless dryRun is
+// true, and then, if --wait was specified, waits for the update to be deployed.
+func (f *waitFlags) streamUpdateCommand(ctx context.Context, streamName string, properties map[string]string, dryRun bool) dataflowCommand {
+	previousVersion := 0
+	return f.command(func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		version, output, err := commands.UpdateStream(ctx, client, streamName, properties, dryRun)
+		previousVersion = version
+		return output, err
+	}, func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		return commands.WaitForStreamUpdate(ctx, client, streamName, previousVersion, *f.timeout, waitPollInterval, progressWriter)
+	})
+}
+
+// taskLaunchCommand returns a command which launches the given task and then, if --wait was specified, waits for the
+// task execution to end.
+func (f *waitFlags) taskLaunchCommand(ctx context.Context, taskName string, launch dataflow.TaskLaunch) dataflowCommand {
+	var executionId int64
+	return f.command(func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		id, output, err := commands.LaunchTask(ctx, client, taskName, launch)
+		executionId = id
+		return output, err
+	}, func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		return commands.WaitForTaskExecution(ctx, client, executionId, *f.timeout, waitPollInterval, progressWriter)
+	})
+}
+
+// command returns a command which runs the given command and then, if --wait was specified, the given wait command,
+// writing the output of the first command as progress.
+func (f *waitFlags) command(command dataflowCommand, wait dataflowCommand) dataflowCommand {
+	return func(client dataflow.Client, progressWriter io.Writer) (string, error) {
+		output, err := command(client, progressWriter)
+		if err != nil || !*f.wait {
+			return output, err
+		}
+		fmt.Fprint(progressWriter, output)
+		return wait(client, progressWriter)
+	}
+}
+
+// propertiesFlags holds the flags which specify deployment properties.
+type propertiesFlags struct {
+	values cli.PropertiesFlag
+	file   *string
+}
+
+func addPropertiesFlags(flagSet *flag.FlagSet) *propertiesFlags {
+	flags := &propertiesFlags{
+		values: cli.PropertiesFlag{},
+		file:   flagSet.String(propertiesFileFlag, "", ""),
+	}
+	flagSet.Var(flags.values, propertiesFlag, "")
+	return flags
+}
+
+func (f *propertiesFlags) specified() bool {
+	return len(f.values) > 0 || *f.file != ""
+}
+
+// properties returns the properties read from the file specified using --properties-file, if any, overridden by those
+// specified using --properties. If the file cannot be read, the failure is diagnosed.
+func (f *propertiesFlags) properties() map[string]string {
+	if *f.file == "" {
+		return cli.MergeProperties(f.values)
+	}
+	fileProperties, err := cli.ReadPropertiesFile(*f.file)
 	if err != nil {
-		return err
+		format.Diagnose(err.Error(), os.Stderr, func() {
+			os.Exit(1)
+		})
 	}
+	return cli.MergeProperties(fileProperties, f.values)
+}
 
-	downloadCache, err := cache.NewCache(progressWriter)
+// resolvedServer is the dataflow server targeted by a command together with the key under which it is cached. Servers
+// targeted using --url have an empty key and are not cached.
+type resolvedServer struct {
+	*serviceutil.CachedServer
+	key   serviceutil.ServerKey
+	cache serviceutil.ServerCache
+}
+
+// resolveServer resolves the server URLs of the given target, using the given cache, and reports how they were
+// resolved.
+func resolveServer(ctx context.Context, cliConnection plugin.CliConnection, serverCache serviceutil.ServerCache, target serverTarget, accessToken string, authClient httpclient.AuthenticatedClient, progressWriter io.Writer) (*resolvedServer, error) {
+	if target.url != "" {
+		return &resolvedServer{
+			CachedServer: &serviceutil.CachedServer{ServerUrls: serviceutil.ServerUrls{DataflowUrl: target.url}},
+			cache:        serverCache,
+		}, nil
+	}
+
+	key, err := serviceutil.ServerKeyFor(cliConnection, target.org, target.space, target.serviceInstanceName)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	httpHelper := download.NewHttpHelper()
-	downloader, err := download.NewDownloader(downloadCache, httpHelper, progressWriter)
+	server, err := serviceutil.ResolveServer(ctx, cliConnection, serverCache, key, accessToken, authClient)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	filePath, err := downloader.DownloadFile(url, checksum, hashFunc)
+	cached := ""
+	if server.Cached {
+		cached = ", cached"
+	}
+	fmt.Fprintf(progressWriter, "Using dataflow server %s (URL resolved from %s%s)\n", format.Bold(format.Cyan(server.DataflowUrl)), server.Method, cached)
+	return &resolvedServer{CachedServer: server, key: key, cache: serverCache}, nil
+}
+
+// about returns the body of the dataflow server's /about resource, fetching and caching it if it is not cached.
+func (s *resolvedServer) about(ctx context.Context, authClient httpclient.AuthenticatedClient, accessToken string) ([]byte, error) {
+	if s.About != nil {
+		return s.About, nil
+	}
+	about, err := dataflow.FetchAbout(ctx, s.DataflowUrl, authClient, accessToken)
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	s.About = about
+	_ = s.cache.Put(s.key, *s.CachedServer)
+	return about, nil
+}
+
+// failed invalidates the cached server, so that its URLs are resolved again by the next command, if the given error
+// from a request to the server is not nil and was reported neither by the server itself nor as the outcome of a
+// command, such as a stream failing to deploy. The error is returned.
+func (s *resolvedServer) failed(err error) error {
+	var exitError *commands.ExitError
+	if err != nil && !reportedByServer(err) && !errors.As(err, &exitError) {
+		_ = s.cache.Invalidate(s.key)
+	}
+	return err
+}
+
+// reportedByServer returns whether the given error is an error response from the dataflow server itself. A 404, 502,
+// or 503 response which does not describe the error as the dataflow server's API does is assumed to come from the
+// router in front of the server, for example because the server's route has been removed or its app is stopped.
+func reportedByServer(err error) bool {
+	var serverError *httpclient.ServerError
+	if !errors.As(err, &serverError) {
+		return false
+	}
+	if len(serverError.Errors) > 0 {
+		return true
+	}
+	switch serverError.StatusCode {
+	case http.StatusNotFound, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return false
+	}
+	return true
+}
+
+// dataflowCommand is a command which uses the REST API of a dataflow server.
+type dataflowCommand func(client dataflow.Client, progressWriter io.Writer) (string, error)
+
+// runDataflowCommand runs the given command as an action using a client for the dataflow server of the given target.
+func runDataflowCommand(ctx context.Context, argsConsumer *cli.ArgConsumer, cliConnection plugin.CliConnection, serverCache serviceutil.ServerCache, tokenSource httpclient.TokenSource, authClient httpclient.AuthenticatedClient, target serverTarget, message string, command dataflowCommand) {
+	runAction(ctx, argsConsumer, cliConnection, message, func(progressWriter io.Writer) (string, error) {
+		accessToken, err := tokenSource.Token()
+		if err != nil {
+			return "", err
+		}
+
+		server, err := resolveServer(ctx, cliConnection, serverCache, target, accessToken, authClient, progressWriter)
+		if err != nil {
+			return "", err
+		}
+
+		output, err := command(dataflow.NewClient(authClient, server.DataflowUrl, accessToken), progressWriter)
+		return output, server.failed(err)
+	})
+}
+
+type urlResolver func() (string, string, hash.Hash, error)
+
+type shellCommandFactory func(fileName string) *exec.Cmd
+
+func downloadAndRunShell(ctx context.Context, httpHelper download.HttpHelper, shellType string, shellDownloadUrl urlResolver, shellCommand shellCommandFactory, progressWriter io.Writer) error {
+	filePath, err := downloadShell(ctx, httpHelper, shellDownloadUrl, progressWriter)
 	if err != nil {
 		return err
 	}
@@ -158,10 +865,107 @@ func downloadAndRunShell(shellType string, shellDownloadUrl urlResolver, shellCo
 	return err
 }
 
+func fetchShell(ctx context.Context, httpHelper download.HttpHelper, shellDownloadUrl urlResolver, progressWriter io.Writer) (string, error) {
+	filePath, err := downloadShell(ctx, httpHelper, shellDownloadUrl, progressWriter)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Shell JAR cached at %s\n", filePath), nil
+}
+
+func downloadShell(ctx context.Context, httpHelper download.HttpHelper, shellDownloadUrl urlResolver, progressWriter io.Writer) (string, error) {
+	url, checksum, hashFunc, err := shellDownloadUrl()
+	if err != nil {
+		return "", err
+	}
+
+	downloadCache, err := cache.NewCache(progressWriter)
+	if err != nil {
+		return "", err
+	}
+	downloader, err := download.NewDownloader(downloadCache, httpHelper, progressWriter)
+	if err != nil {
+		return "", err
+	}
+
+	filePath, err := downloader.DownloadFile(url, checksum, hashFunc)
+	if err != nil {
+		return "", err
+	}
+	return filePath, ctx.Err()
+}
+
+func exportCache(bundleFile string, progressWriter io.Writer) (string, error) {
+	downloadCache, err := cache.NewCache(progressWriter)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(bundleFile)
+	if err != nil {
+		return "", err
+	}
+
+	count, err := downloadCache.Export(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(bundleFile)
+		return "", err
+	}
+	return fmt.Sprintf("Exported %d cache entries\n", count), nil
+}
+
+func importCache(bundleFile string, progressWriter io.Writer) (string, error) {
+	downloadCache, err := cache.NewCache(progressWriter)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(bundleFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	count, err := downloadCache.Import(file)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Imported %d cache entries\n", count), nil
+}
+
 func getDataflowServerInstanceName(ac *cli.ArgConsumer) string {
 	return ac.Consume(1, "dataflow server service instance name")
 }
 
+func getStreamName(ac *cli.ArgConsumer, target serverTarget) string {
+	return ac.Consume(target.firstArg(), "stream name")
+}
+
+func getTaskName(ac *cli.ArgConsumer, target serverTarget) string {
+	return ac.Consume(target.firstArg(), "task name")
+}
+
+// getTaskExecutionStatuses parses a comma-separated list of task execution statuses, ignoring case.
+func getTaskExecutionStatuses(value string, command string) []string {
+	statuses := []string{}
+	if value == "" {
+		return statuses
+	}
+	for _, status := range strings.Split(value, ",") {
+		status = strings.ToUpper(strings.TrimSpace(status))
+		switch status {
+		case dataflow.TaskExecutionRunning, dataflow.TaskExecutionComplete, dataflow.TaskExecutionError, dataflow.TaskExecutionUnknown:
+			statuses = append(statuses, status)
+		default:
+			diagnoseWithHelp(fmt.Sprintf("Incorrect usage: invalid task execution status '%s'.", status), command)
+		}
+	}
+	return statuses
+}
+
 func getSkipperServerInstanceName(ac *cli.ArgConsumer) string {
 	return ac.Consume(1, "Skipper server service instance name")
 }
@@ -195,7 +999,265 @@ func (c *Plugin) GetMetadata() plugin.PluginMetadata {
 				HelpText: "Open a dataflow shell to a Spring Cloud Dataflow for PCF dataflow server",
 				Alias:    "dfsh",
 				UsageDetails: plugin.Usage{
-					Usage: "   cf dataflow-shell DATAFLOW_SERVER_SERVICE_INSTANCE_NAME",
+					Usage: `   cf dataflow-shell DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [-o ORG] [-s SPACE]
+   cf dataflow-shell --url URL`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-shell-fetch",
+				HelpText: "Download and cache the dataflow shell JAR without launching it",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-shell-fetch DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [-o ORG] [-s SPACE]
+   cf dataflow-shell-fetch --url URL
+   cf dataflow-shell-fetch --jar-url URL --checksum CHECKSUM`,
+					Options: targetOptions(
+						"-jar-url", "Download the shell JAR from the given URL instead of querying a dataflow server",
+						"-checksum", "SHA-256 or SHA-1 checksum of the shell JAR given by --jar-url",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-create",
+				HelpText: "Create a stream from its definition and optionally deploy it",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-create DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME DEFINITION [--description DESCRIPTION] [--deploy [--properties KEY=VALUE]... [--properties-file FILE]] [-o ORG] [-s SPACE]
+   cf dataflow-stream-create --url URL STREAM_NAME DEFINITION [--description DESCRIPTION] [--deploy [--properties KEY=VALUE]... [--properties-file FILE]]`,
+					Options: targetOptions(
+						"-description", "Description of the stream",
+						"-deploy", "Deploy the stream once it is created",
+						"-properties", "Deployment property of the form KEY=VALUE, which may be specified more than once",
+						"-properties-file", "File of deployment properties in Java properties format",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-deploy",
+				HelpText: "Deploy a stream",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-deploy DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [--properties KEY=VALUE]... [--properties-file FILE] [--wait [--timeout DURATION]] [-o ORG] [-s SPACE]
+   cf dataflow-stream-deploy --url URL STREAM_NAME [--properties KEY=VALUE]... [--properties-file FILE] [--wait [--timeout DURATION]]`,
+					Options: targetOptions(
+						"-properties", "Deployment property of the form KEY=VALUE, which may be specified more than once",
+						"-properties-file", "File of deployment properties in Java properties format",
+						"-wait", "Wait until the stream is deployed, exiting with a distinct code if it fails or is not deployed in time",
+						"-timeout", "Maximum time to wait, such as 90s or 15m, with --wait (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-undeploy",
+				HelpText: "Undeploy a stream",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-undeploy DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [-o ORG] [-s SPACE]
+   cf dataflow-stream-undeploy --url URL STREAM_NAME`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-stream-destroy",
+				HelpText: "Destroy a stream, undeploying it if necessary",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-destroy DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [-o ORG] [-s SPACE]
+   cf dataflow-stream-destroy --url URL STREAM_NAME`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-stream-update",
+				HelpText: "Update a deployed stream, showing the resulting property changes",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-update DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [--properties KEY=VALUE]... [--properties-file FILE] [--dry-run | --wait [--timeout DURATION]] [-o ORG] [-s SPACE]
+   cf dataflow-stream-update --url URL STREAM_NAME [--properties KEY=VALUE]... [--properties-file FILE] [--dry-run | --wait [--timeout DURATION]]`,
+					Options: targetOptions(
+						"-properties", "Deployment property of the form KEY=VALUE, which may be specified more than once",
+						"-properties-file", "File of deployment properties in Java properties format",
+						"-dry-run", "Show the property changes without updating the stream",
+						"-wait", "Wait until the stream's new release is deployed, exiting with a distinct code if it fails or is not deployed in time",
+						"-timeout", "Maximum time to wait, such as 90s or 15m, with --wait (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-rollback",
+				HelpText: "Roll back a stream to a previous release, showing the resulting property changes",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-rollback DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [VERSION] [--dry-run] [-o ORG] [-s SPACE]
+   cf dataflow-stream-rollback --url URL STREAM_NAME [VERSION] [--dry-run]`,
+					Options: targetOptions(
+						"-dry-run", "Show the property changes without rolling back the stream",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-scale",
+				HelpText: "Scale an application of a deployed stream",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-scale DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME APP_LABEL COUNT [--properties KEY=VALUE]... [--properties-file FILE] [--wait [--timeout DURATION]] [-o ORG] [-s SPACE]
+   cf dataflow-stream-scale --url URL STREAM_NAME APP_LABEL COUNT [--properties KEY=VALUE]... [--properties-file FILE] [--wait [--timeout DURATION]]`,
+					Options: targetOptions(
+						"-properties", "Deployment property of the form KEY=VALUE, which may be specified more than once",
+						"-properties-file", "File of deployment properties in Java properties format",
+						"-wait", "Wait until the application has the given number of instances deployed",
+						"-timeout", "Maximum time to wait, such as 90s or 15m, with --wait (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-wait",
+				HelpText: "Wait for a stream to be deployed",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-wait DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [--timeout DURATION] [-o ORG] [-s SPACE]
+   cf dataflow-stream-wait --url URL STREAM_NAME [--timeout DURATION]`,
+					Options: targetOptions(
+						"-timeout", "Maximum time to wait, such as 90s or 15m (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-stream-history",
+				HelpText: "List the releases of a stream",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream-history DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [--changes] [-o ORG] [-s SPACE]
+   cf dataflow-stream-history --url URL STREAM_NAME [--changes]`,
+					Options: targetOptions(
+						"-changes", "Show the property changes made by each release",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-tasks",
+				HelpText: "List the tasks of a Spring Cloud Dataflow for PCF dataflow server",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-tasks DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [-o ORG] [-s SPACE]
+   cf dataflow-tasks --url URL`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-task-create",
+				HelpText: "Create a task from its definition",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-create DATAFLOW_SERVER_SERVICE_INSTANCE_NAME TASK_NAME DEFINITION [--description DESCRIPTION] [-o ORG] [-s SPACE]
+   cf dataflow-task-create --url URL TASK_NAME DEFINITION [--description DESCRIPTION]`,
+					Options: targetOptions(
+						"-description", "Description of the task",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-task-destroy",
+				HelpText: "Destroy a task",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-destroy DATAFLOW_SERVER_SERVICE_INSTANCE_NAME TASK_NAME [--cleanup] [-o ORG] [-s SPACE]
+   cf dataflow-task-destroy --url URL TASK_NAME [--cleanup]`,
+					Options: targetOptions(
+						"-cleanup", "Also clean up the platform resources of the task's executions",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-task-launch",
+				HelpText: "Launch a task, optionally waiting for it to end",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-launch DATAFLOW_SERVER_SERVICE_INSTANCE_NAME TASK_NAME [--arguments ARGUMENTS]... [--properties KEY=VALUE]... [--properties-file FILE] [--platform PLATFORM] [--wait [--timeout DURATION]] [-o ORG] [-s SPACE]
+   cf dataflow-task-launch --url URL TASK_NAME [--arguments ARGUMENTS]... [--properties KEY=VALUE]... [--properties-file FILE] [--platform PLATFORM] [--wait [--timeout DURATION]]`,
+					Options: targetOptions(
+						"-arguments", "Command line arguments of the task, which may be specified more than once",
+						"-properties", "Launch property of the form KEY=VALUE, which may be specified more than once",
+						"-properties-file", "File of launch properties in Java properties format",
+						"-platform", "Platform on which to launch the task, if not the default platform",
+						"-wait", "Wait until the task execution ends and exit with the task's exit code. Code 2 also means the task ended without an exit code between 1 and 255, and code 4 that it did not end in time",
+						"-timeout", "Maximum time to wait, such as 90s or 15m, with --wait (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-task-executions",
+				HelpText: "List task executions, most recent first",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-executions DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [--task TASK_NAME] [--status STATUS[,STATUS]...] [--page PAGE] [--page-size SIZE] [-o ORG] [-s SPACE]
+   cf dataflow-task-executions --url URL [--task TASK_NAME] [--status STATUS[,STATUS]...] [--page PAGE] [--page-size SIZE]`,
+					Options: targetOptions(
+						"-task", "List only the executions of the given task",
+						"-status", "List only executions with the given statuses: RUNNING, COMPLETE, ERROR, or UNKNOWN",
+						"-page", "Page to list, numbered from 1 (default 1)",
+						"-page-size", "Number of task executions per page (default 20)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-task-execution",
+				HelpText: "Show a task execution and optionally its log",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-execution DATAFLOW_SERVER_SERVICE_INSTANCE_NAME TASK_EXECUTION_ID [--logs] [-o ORG] [-s SPACE]
+   cf dataflow-task-execution --url URL TASK_EXECUTION_ID [--logs]`,
+					Options: targetOptions(
+						"-logs", "Also show the log of the task execution",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-task-cleanup",
+				HelpText: "Remove task executions and their data",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-task-cleanup DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [--task TASK_NAME] [--completed] [--older-than DAYS] [--keep-last COUNT] [--dry-run] [--parallelism COUNT] [-o ORG] [-s SPACE]
+   cf dataflow-task-cleanup --url URL [--task TASK_NAME] [--completed] [--older-than DAYS] [--keep-last COUNT] [--dry-run] [--parallelism COUNT]`,
+					Options: targetOptions(
+						"-task", "Remove only the executions of the given task",
+						"-completed", "Remove only task executions which completed successfully with exit code 0",
+						"-older-than", "Remove only task executions which ended more than the given number of days ago",
+						"-keep-last", "Keep the given number of most recent ended executions of each task",
+						"-dry-run", "List the task executions which would be removed without removing them",
+						"-parallelism", "Maximum number of task executions removed concurrently (default 4)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-services",
+				HelpText: "List Spring Cloud Dataflow for PCF service instances with the status of their servers",
+				UsageDetails: plugin.Usage{
+					Usage: "   cf dataflow-services [--all-spaces]",
+					Options: map[string]string{
+						"-all-spaces": "List service instances in all the spaces you can see instead of only the targeted space",
+					},
+				},
+			},
+			{
+				Name:     "dataflow-wait",
+				HelpText: "Wait for a Spring Cloud Dataflow for PCF service instance to become ready",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-wait DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [--timeout DURATION] [-o ORG] [-s SPACE]
+   cf dataflow-wait --url URL [--timeout DURATION]`,
+					Options: targetOptions(
+						"-timeout", "Maximum time to wait, such as 90s or 15m (default 10m)",
+					),
+				},
+			},
+			{
+				Name:     "dataflow-streams",
+				HelpText: "List the streams of a Spring Cloud Dataflow for PCF dataflow server",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-streams DATAFLOW_SERVER_SERVICE_INSTANCE_NAME [-o ORG] [-s SPACE]
+   cf dataflow-streams --url URL`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-stream",
+				HelpText: "Show the definition, deployment properties, and application status of a stream",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-stream DATAFLOW_SERVER_SERVICE_INSTANCE_NAME STREAM_NAME [-o ORG] [-s SPACE]
+   cf dataflow-stream --url URL STREAM_NAME`,
+					Options: targetOptions(),
+				},
+			},
+			{
+				Name:     "dataflow-cache",
+				HelpText: "Export or import the shell JAR cache for transfer to another machine",
+				UsageDetails: plugin.Usage{
+					Usage: `   cf dataflow-cache export BUNDLE_FILE
+   cf dataflow-cache import BUNDLE_FILE`,
 				},
 			},
 			// {
@@ -210,6 +1272,20 @@ func (c *Plugin) GetMetadata() plugin.PluginMetadata {
 	}
 }
 
+// targetOptions returns the usage of the flags which select the server targeted by a command together with the given
+// usage of other flags.
+func targetOptions(options ...string) map[string]string {
+	usage := map[string]string{
+		"-url": "Target the dataflow server at the given URL instead of a service instance",
+		"o":    "Org of the service instance, if not the targeted org",
+		"s":    "Space of the service instance, if not the targeted space",
+	}
+	for i := 0; i+1 < len(options); i += 2 {
+		usage[options[i]] = options[i+1]
+	}
+	return usage
+}
+
 func main() {
 	if len(os.Args) == 1 {
 		fmt.Println("This program is a plugin which expects to be installed into the cf CLI. It is not intended to be run stand-alone.")
@@ -220,10 +1296,27 @@ func main() {
 	plugin.Start(new(Plugin))
 }
 
-func runAction(argsConsumer *cli.ArgConsumer, cliConnection plugin.CliConnection, message string, action func(progressWriter io.Writer) (string, error)) {
+func runAction(ctx context.Context, argsConsumer *cli.ArgConsumer, cliConnection plugin.CliConnection, message string, action func(progressWriter io.Writer) (string, error)) {
 	argsConsumer.CheckAllConsumed()
 
-	format.RunAction(cliConnection, message, action, os.Stdout, func() {
-		os.Exit(1)
+	var actionErr error
+	format.RunAction(cliConnection, message, func(progressWriter io.Writer) (string, error) {
+		output, err := action(progressWriter)
+		if err != nil && ctx.Err() == context.Canceled {
+			return "", errors.New("Interrupted")
+		}
+		actionErr = err
+		return output, err
+	}, os.Stdout, func() {
+		os.Exit(exitCode(actionErr))
 	})
 }
+
+// exitCode returns the code with which the plugin should exit when an action fails with the given error.
+func exitCode(err error) int {
+	var exitError *commands.ExitError
+	if errors.As(err, &exitError) {
+		return exitError.Code
+	}
+	return 1
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package pluginutil
+
+import (
+	"context"
+	"sync"
+)
+
+// ForEachParallel calls the given function with each index from 0 to count-1 using a pool of at most parallelism
+// workers, and returns once all the calls have returned. No further calls are started once the given context is done.
+// A parallelism less than one is treated as one.
+func ForEachParallel(ctx context.Context, count int, parallelism int, f func(i int)) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	if parallelism > count {
+		parallelism = count
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for w := 0; w < parallelism; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				f(i)
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			i = count
+		}
+	}
+	close(indices)
+	wg.Wait()
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package pluginutil_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+var _ = Describe("ForEachParallel", func() {
+	It("should call the function once for each index", func() {
+		var mutex sync.Mutex
+		called := map[int]int{}
+		pluginutil.ForEachParallel(context.Background(), 20, 4, func(i int) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			called[i]++
+		})
+		Expect(called).To(HaveLen(20))
+		for i := 0; i < 20; i++ {
+			Expect(called[i]).To(Equal(1))
+		}
+	})
+
+	It("should bound the number of concurrent calls", func() {
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		pluginutil.ForEachParallel(context.Background(), 12, 3, func(i int) {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+		})
+		Expect(maxRunning).To(Equal(3))
+	})
+
+	It("should handle no indices", func() {
+		pluginutil.ForEachParallel(context.Background(), 0, 3, func(i int) {
+			Fail("unexpected call")
+		})
+	})
+
+	It("should not start calls once the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		var mutex sync.Mutex
+		calls := 0
+		pluginutil.ForEachParallel(ctx, 100, 1, func(i int) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			calls++
+			if i == 4 {
+				cancel()
+			}
+		})
+		Expect(calls).To(BeNumerically("<=", 6))
+	})
+})
@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package pluginutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TimeoutError is returned by Poll when the condition being polled for is not met in time.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s", e.Timeout)
+}
+
+// Poll calls the given function, waiting for the given interval between calls, until it returns true or an error or
+// until the given timeout elapses, in which case the error is a *TimeoutError. The function is passed a context which
+// is done when the timeout elapses so that any requests it makes are abandoned. A zero timeout means no timeout.
+func Poll(ctx context.Context, timeout time.Duration, interval time.Duration, f func(ctx context.Context) (bool, error)) error {
+	pollCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		done, err := f(pollCtx)
+		if done && err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if pollCtx.Err() != nil {
+			return &TimeoutError{Timeout: timeout}
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-pollCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return &TimeoutError{Timeout: timeout}
+		}
+	}
+}
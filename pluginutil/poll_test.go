@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package pluginutil_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/pluginutil"
+)
+
+var _ = Describe("Poll", func() {
+	It("should poll until the function returns true", func() {
+		calls := 0
+		err := pluginutil.Poll(context.Background(), time.Second, time.Millisecond, func(ctx context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(3))
+	})
+
+	It("should stop polling when the function returns an error", func() {
+		calls := 0
+		err := pluginutil.Poll(context.Background(), time.Second, time.Millisecond, func(ctx context.Context) (bool, error) {
+			calls++
+			return false, errors.New("failed")
+		})
+		Expect(err).To(MatchError("failed"))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should time out", func() {
+		err := pluginutil.Poll(context.Background(), 20*time.Millisecond, time.Millisecond, func(ctx context.Context) (bool, error) {
+			return false, nil
+		})
+		var timeoutError *pluginutil.TimeoutError
+		Expect(errors.As(err, &timeoutError)).To(BeTrue())
+		Expect(err).To(MatchError("Timed out after 20ms"))
+	})
+
+	It("should time out when the function is cut off by the timeout", func() {
+		err := pluginutil.Poll(context.Background(), 20*time.Millisecond, time.Millisecond, func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		})
+		Expect(err).To(MatchError("Timed out after 20ms"))
+	})
+
+	It("should stop polling when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := pluginutil.Poll(ctx, time.Second, time.Millisecond, func(ctx context.Context) (bool, error) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return false, nil
+		})
+		Expect(err).To(Equal(context.Canceled))
+		Expect(calls).To(Equal(2))
+	})
+})
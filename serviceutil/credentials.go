@@ -0,0 +1,174 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// Methods by which server URLs may be resolved.
+const (
+	ResolvedFromServiceKey          = "service key"
+	ResolvedFromServiceBinding      = "service binding"
+	ResolvedFromBrokerRedirect      = "service broker redirect"
+	ResolvedFromUserProvidedService = "user-provided service"
+)
+
+// Credential names which hold server URLs.
+const (
+	DataflowUrlCredential = "dataflow-url"
+	SkipperUrlCredential  = "skipper-api"
+
+	// UriCredential holds the dataflow server URL in the credentials of a user-provided service instance.
+	UriCredential = "uri"
+)
+
+// ServerUrls holds the URLs of the dataflow server and, if known, the Skipper server of a service instance together
+// with the method by which they were resolved.
+type ServerUrls struct {
+	DataflowUrl string
+	SkipperUrl  string
+	Method      string
+}
+
+type userProvidedServiceResp struct {
+	Entity struct {
+		Credentials map[string]interface{} `json:"credentials"`
+	} `json:"entity"`
+}
+
+type credentialsResp struct {
+	Resources []struct {
+		Entity struct {
+			Credentials map[string]interface{} `json:"credentials"`
+		} `json:"entity"`
+	} `json:"resources"`
+}
+
+// ResolveServerUrls obtains the server URLs of a service instance with a specific name. The URLs are taken from the
+// credentials of a service key of the service instance or, failing that, of a service binding of the service
+// instance. If neither provides a dataflow server URL, the service broker is asked for the dataflow server URL as
+// in ServiceInstanceURL. The URLs of a user-provided service instance are taken from its credentials, in which the
+// dataflow server URL may be given as "uri".
+func ResolveServerUrls(ctx context.Context, cliConnection plugin.CliConnection, serviceInstanceName string, accessToken string, authClient httpclient.AuthenticatedClient) (*ServerUrls, error) {
+	return ResolveServerUrlsInSpace(ctx, cliConnection, "", "", serviceInstanceName, accessToken, authClient)
+}
+
+// ResolveServerUrlsInSpace is like ResolveServerUrls but finds the service instance in the given org and space, as in
+// FindServiceInstance.
+func ResolveServerUrlsInSpace(ctx context.Context, cliConnection plugin.CliConnection, org string, space string, serviceInstanceName string, accessToken string, authClient httpclient.AuthenticatedClient) (*ServerUrls, error) {
+	serviceInstance, err := FindServiceInstance(cliConnection, org, space, serviceInstanceName)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveServiceInstanceUrls(ctx, cliConnection, serviceInstance, accessToken, authClient)
+}
+
+// ResolveServiceInstanceUrls is like ResolveServerUrls but uses a service instance which has already been found.
+func ResolveServiceInstanceUrls(ctx context.Context, cliConnection plugin.CliConnection, serviceInstance *ServiceInstance, accessToken string, authClient httpclient.AuthenticatedClient) (*ServerUrls, error) {
+	if serviceInstance.IsUserProvided {
+		return userProvidedServerUrls(cliConnection, serviceInstance.Name, serviceInstance.Guid)
+	}
+
+	for _, source := range []struct {
+		method string
+		path   string
+	}{
+		{ResolvedFromServiceKey, "service_keys"},
+		{ResolvedFromServiceBinding, "service_bindings"},
+	} {
+		credentials := serviceCredentials(cliConnection, fmt.Sprintf("/v2/service_instances/%s/%s", serviceInstance.Guid, source.path))
+		if urls := serverUrlsFromCredentials(credentials, source.method); urls != nil {
+			return urls, nil
+		}
+	}
+
+	dataflowUrl, err := brokerRedirectURL(ctx, serviceInstance.DashboardUrl, accessToken, authClient)
+	if err != nil {
+		return nil, err
+	}
+	return &ServerUrls{DataflowUrl: dataflowUrl, Method: ResolvedFromBrokerRedirect}, nil
+}
+
+func userProvidedServerUrls(cliConnection plugin.CliConnection, serviceInstanceName string, guid string) (*ServerUrls, error) {
+	output, err := cliConnection.CliCommandWithoutTerminalOutput("curl", fmt.Sprintf("/v2/user_provided_service_instances/%s", guid))
+	if err != nil {
+		return nil, fmt.Errorf("Credentials of user-provided service instance %s not available: %s", serviceInstanceName, err)
+	}
+
+	var resp userProvidedServiceResp
+	if err := json.Unmarshal([]byte(strings.Join(output, "\n")), &resp); err != nil {
+		return nil, fmt.Errorf("Invalid response for user-provided service instance %s: %s", serviceInstanceName, err)
+	}
+
+	urls := serverUrlsFromCredentials([]map[string]interface{}{resp.Entity.Credentials}, ResolvedFromUserProvidedService)
+	if urls == nil {
+		return nil, fmt.Errorf("User-provided service instance %s has no %s or %s credential", serviceInstanceName, UriCredential, DataflowUrlCredential)
+	}
+	return urls, nil
+}
+
+// serviceCredentials returns the credentials of the service keys or bindings listed by the given Cloud Controller
+// path. Failures are ignored since the credentials may not be visible to the user.
+func serviceCredentials(cliConnection plugin.CliConnection, path string) []map[string]interface{} {
+	output, err := cliConnection.CliCommandWithoutTerminalOutput("curl", path)
+	if err != nil {
+		return nil
+	}
+
+	var resp credentialsResp
+	if err := json.Unmarshal([]byte(strings.Join(output, "\n")), &resp); err != nil {
+		return nil
+	}
+
+	credentials := make([]map[string]interface{}, 0, len(resp.Resources))
+	for _, resource := range resp.Resources {
+		credentials = append(credentials, resource.Entity.Credentials)
+	}
+	return credentials
+}
+
+// serverUrlsFromCredentials returns the server URLs from the first of the given credentials which provides a dataflow
+// server URL, either as "dataflow-url" or as "uri", or nil if none does.
+func serverUrlsFromCredentials(credentials []map[string]interface{}, method string) *ServerUrls {
+	for _, credential := range credentials {
+		dataflowUrl := credentialString(credential, DataflowUrlCredential)
+		if dataflowUrl == "" {
+			dataflowUrl = credentialString(credential, UriCredential)
+		}
+		if dataflowUrl == "" {
+			continue
+		}
+		return &ServerUrls{
+			DataflowUrl: dataflowUrl,
+			SkipperUrl:  credentialString(credential, SkipperUrlCredential),
+			Method:      method,
+		}
+	}
+	return nil
+}
+
+func credentialString(credential map[string]interface{}, name string) string {
+	value, _ := credential[name].(string)
+	return strings.TrimSuffix(strings.TrimSpace(value), "/")
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+)
+
+var _ = Describe("ResolveServerUrls", func() {
+	const (
+		errMessage       = "some error"
+		serviceKeysPath  = "/v2/service_instances/guid/service_keys"
+		serviceBindsPath = "/v2/service_instances/guid/service_bindings"
+	)
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		authClient        *httpclientfakes.FakeAuthenticatedClient
+		curlOutput        map[string][]string
+		serverUrls        *serviceutil.ServerUrls
+		err               error
+	)
+
+	BeforeEach(func() {
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{
+			Guid:         "guid",
+			DashboardUrl: "https://spring-cloud-broker.some.host.name/instances/guid/dashboard",
+		}, nil)
+		curlOutput = map[string][]string{}
+		fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+			Expect(args[0]).To(Equal("curl"))
+			output, ok := curlOutput[args[1]]
+			if !ok {
+				return nil, errors.New(errMessage)
+			}
+			return output, nil
+		}
+		authClient = &httpclientfakes.FakeAuthenticatedClient{}
+		authClient.DoAuthenticatedGetContextReturns(nil, http.StatusFound, http.Header{"Location": []string{"https://dataflow-server-url"}}, nil)
+	})
+
+	JustBeforeEach(func() {
+		serverUrls, err = serviceutil.ResolveServerUrls(context.Background(), fakeCliConnection, "service-instance-name", "access-token", authClient)
+	})
+
+	Context("when the service instance is not found", func() {
+		BeforeEach(func() {
+			fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{}, errors.New(errMessage))
+		})
+
+		It("should propagate the error", func() {
+			Expect(err).To(MatchError("Service instance not found: " + errMessage))
+		})
+	})
+
+	Context("when a service key provides the server URLs", func() {
+		BeforeEach(func() {
+			curlOutput[serviceKeysPath] = []string{`{"resources": [`,
+				`{"entity": {"credentials": {"client-id": "id"}}},`,
+				`{"entity": {"credentials": {"dataflow-url": "https://dataflow-key-url/", "skipper-api": "https://skipper-key-url/api"}}}`,
+				`]}`}
+		})
+
+		It("should use the service key credentials", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*serverUrls).To(Equal(serviceutil.ServerUrls{
+				DataflowUrl: "https://dataflow-key-url",
+				SkipperUrl:  "https://skipper-key-url/api",
+				Method:      serviceutil.ResolvedFromServiceKey,
+			}))
+			Expect(fakeCliConnection.CliCommandWithoutTerminalOutputArgsForCall(0)).To(Equal([]string{"curl", serviceKeysPath}))
+		})
+
+		It("should not contact the service broker", func() {
+			Expect(authClient.DoAuthenticatedGetContextCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when only a service binding provides the server URLs", func() {
+		BeforeEach(func() {
+			curlOutput[serviceKeysPath] = []string{`{"resources": []}`}
+			curlOutput[serviceBindsPath] = []string{`{"resources": [{"entity": {"credentials": {"dataflow-url": "https://dataflow-binding-url"}}}]}`}
+		})
+
+		It("should use the service binding credentials", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*serverUrls).To(Equal(serviceutil.ServerUrls{
+				DataflowUrl: "https://dataflow-binding-url",
+				Method:      serviceutil.ResolvedFromServiceBinding,
+			}))
+		})
+	})
+
+	Context("when no credentials provide the server URLs", func() {
+		BeforeEach(func() {
+			curlOutput[serviceKeysPath] = []string{`{"code": 10003, "description": "You are not authorized to perform the requested action"}`}
+		})
+
+		It("should fall back to the service broker redirect", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*serverUrls).To(Equal(serviceutil.ServerUrls{
+				DataflowUrl: "https://dataflow-server-url",
+				Method:      serviceutil.ResolvedFromBrokerRedirect,
+			}))
+			_, url, _ := authClient.DoAuthenticatedGetContextArgsForCall(0)
+			Expect(url).To(Equal("https://spring-cloud-broker.some.host.name/instances/guid"))
+		})
+
+		Context("when the service broker fails", func() {
+			BeforeEach(func() {
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusBadGateway, http.Header{}, errors.New(errMessage))
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("service broker failed: " + errMessage))
+			})
+		})
+	})
+
+	Context("when the service instance is user-provided", func() {
+		const userProvidedPath = "/v2/user_provided_service_instances/guid"
+
+		BeforeEach(func() {
+			fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{Guid: "guid", IsUserProvided: true}, nil)
+		})
+
+		Context("when its credentials contain a URI", func() {
+			BeforeEach(func() {
+				curlOutput[userProvidedPath] = []string{`{"entity": {"credentials": {"uri": "https://dataflow.apps.example.com/"}}}`}
+			})
+
+			It("should use the URI", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*serverUrls).To(Equal(serviceutil.ServerUrls{
+					DataflowUrl: "https://dataflow.apps.example.com",
+					Method:      serviceutil.ResolvedFromUserProvidedService,
+				}))
+				Expect(fakeCliConnection.CliCommandWithoutTerminalOutputCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when its credentials do not contain a URI", func() {
+			BeforeEach(func() {
+				curlOutput[userProvidedPath] = []string{`{"entity": {"credentials": {"username": "user"}}}`}
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("User-provided service instance service-instance-name has no uri or dataflow-url credential"))
+			})
+		})
+
+		Context("when its credentials are not available", func() {
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("Credentials of user-provided service instance service-instance-name not available: " + errMessage))
+			})
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+// DataflowServiceLabel is the label of the Spring Cloud Data Flow for PCF service offering.
+const DataflowServiceLabel = "p-dataflow"
+
+// ServiceInstanceSummary describes a service instance of the Spring Cloud Data Flow for PCF service offering.
+type ServiceInstanceSummary struct {
+	ServiceInstance
+	Org   string
+	Space string
+	Plan  string
+
+	// LastOperation describes the last operation on the service instance, for example "create succeeded".
+	LastOperation string
+}
+
+// ListDataflowServiceInstances lists the service instances of the Spring Cloud Data Flow for PCF service offering
+// in the targeted space or, if allSpaces is true, in all the spaces visible to the user. Service instances are
+// sorted by org, space, and name.
+func ListDataflowServiceInstances(cliConnection plugin.CliConnection, allSpaces bool) ([]ServiceInstanceSummary, error) {
+	services, err := curlAll(cliConnection, "/v2/services?q="+url.QueryEscape("label:"+DataflowServiceLabel))
+	if err != nil {
+		return nil, err
+	}
+
+	plans := map[string]string{}
+	for _, service := range services {
+		servicePlans, err := curlAll(cliConnection, fmt.Sprintf("/v2/services/%s/service_plans", service.Metadata.Guid))
+		if err != nil {
+			return nil, err
+		}
+		for _, plan := range servicePlans {
+			plans[plan.Metadata.Guid] = plan.Entity.Name
+		}
+	}
+	if len(plans) == 0 {
+		return []ServiceInstanceSummary{}, nil
+	}
+
+	planGuids := make([]string, 0, len(plans))
+	for guid := range plans {
+		planGuids = append(planGuids, guid)
+	}
+	sort.Strings(planGuids)
+	query := "?q=" + url.QueryEscape("service_plan_guid IN "+strings.Join(planGuids, ","))
+
+	names := newSpaceNames(cliConnection)
+	path := "/v2/service_instances" + query
+	if !allSpaces {
+		currentOrg, err := cliConnection.GetCurrentOrg()
+		if err != nil {
+			return nil, fmt.Errorf("Targeted org not available: %s", err)
+		}
+		currentSpace, err := cliConnection.GetCurrentSpace()
+		if err != nil {
+			return nil, fmt.Errorf("Targeted space not available: %s", err)
+		}
+		names.spaces[currentSpace.Guid] = spaceName{org: currentOrg.Name, space: currentSpace.Name}
+		path = fmt.Sprintf("/v2/spaces/%s/service_instances%s", currentSpace.Guid, query)
+	}
+
+	serviceInstances, err := curlAll(cliConnection, path)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]ServiceInstanceSummary, 0, len(serviceInstances))
+	for _, serviceInstance := range serviceInstances {
+		name, err := names.of(serviceInstance.Entity.SpaceGuid)
+		if err != nil {
+			return nil, err
+		}
+		lastOperation := serviceInstance.Entity.LastOperation
+		summaries = append(summaries, ServiceInstanceSummary{
+			ServiceInstance: ServiceInstance{
+				Guid:         serviceInstance.Metadata.Guid,
+				Name:         serviceInstance.Entity.Name,
+				DashboardUrl: serviceInstance.Entity.DashboardUrl,
+			},
+			Org:           name.org,
+			Space:         name.space,
+			Plan:          plans[serviceInstance.Entity.ServicePlanGuid],
+			LastOperation: strings.TrimSpace(lastOperation.Type + " " + lastOperation.State),
+		})
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if a.Org != b.Org {
+			return a.Org < b.Org
+		}
+		if a.Space != b.Space {
+			return a.Space < b.Space
+		}
+		return a.Name < b.Name
+	})
+	return summaries, nil
+}
+
+type spaceName struct {
+	org   string
+	space string
+}
+
+// spaceNames looks up the names of spaces and their orgs, looking up each space and org once.
+type spaceNames struct {
+	cliConnection plugin.CliConnection
+	spaces        map[string]spaceName
+	orgs          map[string]string
+}
+
+func newSpaceNames(cliConnection plugin.CliConnection) *spaceNames {
+	return &spaceNames{
+		cliConnection: cliConnection,
+		spaces:        map[string]spaceName{},
+		orgs:          map[string]string{},
+	}
+}
+
+func (n *spaceNames) of(spaceGuid string) (spaceName, error) {
+	if name, ok := n.spaces[spaceGuid]; ok {
+		return name, nil
+	}
+
+	var space resource
+	if err := curlInto(n.cliConnection, "/v2/spaces/"+spaceGuid, &space); err != nil {
+		return spaceName{}, err
+	}
+	orgGuid := space.Entity.OrganizationGuid
+	orgName, ok := n.orgs[orgGuid]
+	if !ok {
+		var org resource
+		if err := curlInto(n.cliConnection, "/v2/organizations/"+orgGuid, &org); err != nil {
+			return spaceName{}, err
+		}
+		orgName = org.Entity.Name
+		n.orgs[orgGuid] = orgName
+	}
+
+	name := spaceName{org: orgName, space: space.Entity.Name}
+	n.spaces[spaceGuid] = name
+	return name, nil
+}
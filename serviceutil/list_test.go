@@ -0,0 +1,143 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+)
+
+var _ = Describe("ListDataflowServiceInstances", func() {
+	const (
+		servicesPath       = "/v2/services?q=label%3Ap-dataflow"
+		plansPath          = "/v2/services/service-guid/service_plans"
+		planQuery          = "?q=service_plan_guid+IN+plan-guid-1%2Cplan-guid-2"
+		spaceInstancesPath = "/v2/spaces/current-space-guid/service_instances" + planQuery
+		allInstancesPath   = "/v2/service_instances" + planQuery
+		nextInstancesPath  = "/v2/service_instances?page=2"
+	)
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		curlOutput        map[string][]string
+		allSpaces         bool
+		summaries         []serviceutil.ServiceInstanceSummary
+		err               error
+	)
+
+	BeforeEach(func() {
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.GetCurrentOrgReturns(plugin_models.Organization{OrganizationFields: plugin_models.OrganizationFields{Name: "org", Guid: "current-org-guid"}}, nil)
+		fakeCliConnection.GetCurrentSpaceReturns(plugin_models.Space{SpaceFields: plugin_models.SpaceFields{Name: "space", Guid: "current-space-guid"}}, nil)
+		curlOutput = map[string][]string{
+			servicesPath: {`{"resources": [{"metadata": {"guid": "service-guid"}, "entity": {"label": "p-dataflow"}}]}`},
+			plansPath: {`{"resources": [{"metadata": {"guid": "plan-guid-1"}, "entity": {"name": "standard"}},`,
+				`{"metadata": {"guid": "plan-guid-2"}, "entity": {"name": "proplan"}}]}`},
+			spaceInstancesPath: {`{"resources": [`,
+				`{"metadata": {"guid": "guid-b"}, "entity": {"name": "b", "service_plan_guid": "plan-guid-2", "space_guid": "current-space-guid", "last_operation": {"type": "update", "state": "in progress"}}},`,
+				`{"metadata": {"guid": "guid-a"}, "entity": {"name": "a", "dashboard_url": "https://broker/a", "service_plan_guid": "plan-guid-1", "space_guid": "current-space-guid", "last_operation": {"type": "create", "state": "succeeded"}}}]}`},
+			allInstancesPath: {`{"next_url": "/v2/service_instances?page=2", "resources": [`,
+				`{"metadata": {"guid": "guid-c"}, "entity": {"name": "c", "service_plan_guid": "plan-guid-1", "space_guid": "other-space-guid"}}]}`},
+			nextInstancesPath: {`{"resources": [`,
+				`{"metadata": {"guid": "guid-d"}, "entity": {"name": "d", "service_plan_guid": "plan-guid-1", "space_guid": "another-space-guid"}},`,
+				`{"metadata": {"guid": "guid-e"}, "entity": {"name": "e", "service_plan_guid": "plan-guid-1", "space_guid": "other-space-guid"}}]}`},
+			"/v2/spaces/other-space-guid":      {`{"metadata": {"guid": "other-space-guid"}, "entity": {"name": "other-space", "organization_guid": "other-org-guid"}}`},
+			"/v2/spaces/another-space-guid":    {`{"metadata": {"guid": "another-space-guid"}, "entity": {"name": "another-space", "organization_guid": "other-org-guid"}}`},
+			"/v2/organizations/other-org-guid": {`{"metadata": {"guid": "other-org-guid"}, "entity": {"name": "other-org"}}`},
+		}
+		fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+			output, ok := curlOutput[args[1]]
+			if !ok {
+				return nil, errors.New("unexpected request " + args[1])
+			}
+			return output, nil
+		}
+		allSpaces = false
+	})
+
+	JustBeforeEach(func() {
+		summaries, err = serviceutil.ListDataflowServiceInstances(fakeCliConnection, allSpaces)
+	})
+
+	It("should list the service instances in the targeted space sorted by name", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(Equal([]serviceutil.ServiceInstanceSummary{
+			{
+				ServiceInstance: serviceutil.ServiceInstance{Guid: "guid-a", Name: "a", DashboardUrl: "https://broker/a"},
+				Org:             "org",
+				Space:           "space",
+				Plan:            "standard",
+				LastOperation:   "create succeeded",
+			},
+			{
+				ServiceInstance: serviceutil.ServiceInstance{Guid: "guid-b", Name: "b"},
+				Org:             "org",
+				Space:           "space",
+				Plan:            "proplan",
+				LastOperation:   "update in progress",
+			},
+		}))
+	})
+
+	Context("when all spaces are listed", func() {
+		BeforeEach(func() {
+			allSpaces = true
+		})
+
+		It("should follow pages and look up org and space names once each", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summaries).To(HaveLen(3))
+			Expect([]string{summaries[0].Space, summaries[0].Name}).To(Equal([]string{"another-space", "d"}))
+			Expect([]string{summaries[1].Space, summaries[1].Name}).To(Equal([]string{"other-space", "c"}))
+			Expect([]string{summaries[2].Space, summaries[2].Name}).To(Equal([]string{"other-space", "e"}))
+			Expect(summaries[0].Org).To(Equal("other-org"))
+
+			paths := []string{}
+			for i := 0; i < fakeCliConnection.CliCommandWithoutTerminalOutputCallCount(); i++ {
+				paths = append(paths, fakeCliConnection.CliCommandWithoutTerminalOutputArgsForCall(i)[1])
+			}
+			Expect(paths).To(ConsistOf(servicesPath, plansPath, allInstancesPath, nextInstancesPath,
+				"/v2/spaces/other-space-guid", "/v2/spaces/another-space-guid", "/v2/organizations/other-org-guid"))
+		})
+	})
+
+	Context("when the offering is not available", func() {
+		BeforeEach(func() {
+			curlOutput[servicesPath] = []string{`{"resources": []}`}
+		})
+
+		It("should return no service instances", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summaries).To(BeEmpty())
+		})
+	})
+
+	Context("when a Cloud Controller request fails", func() {
+		BeforeEach(func() {
+			delete(curlOutput, plansPath)
+		})
+
+		It("should return an error", func() {
+			Expect(err).To(MatchError("Cloud Controller request /v2/services/service-guid/service_plans failed: unexpected request /v2/services/service-guid/service_plans"))
+		})
+	})
+})
@@ -0,0 +1,247 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/cli"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+const (
+	ServerCacheTtlEnvVar = "SCDF_SERVER_CACHE_TTL"
+
+	defaultServerCacheTtl = 10 * time.Minute
+
+	cfHomeEnvVar        = "CF_HOME"
+	homeEnvVar          = "HOME"
+	cfDataDirectory     = ".cf"
+	serverCacheFileName = "servers.json"
+	serverCacheFilePerm = 0600
+	serverCacheDirPerm  = 0755
+	scdfDataDirectory   = "spring-cloud-dataflow-for-pcf"
+)
+
+// ServerKey identifies a service instance by the cf API endpoint, user, org, and space with which it was targeted and
+// by its name. The user is included so that users sharing a cf home directory do not share entries.
+type ServerKey struct {
+	Api             string
+	User            string
+	Org             string
+	Space           string
+	ServiceInstance string
+}
+
+func (k ServerKey) String() string {
+	return strings.Join([]string{k.Api, k.User, k.Org, k.Space, k.ServiceInstance}, " ")
+}
+
+// ServerKeyFor returns the key of the service instance with the given name in the given org and space for the logged
+// in user. Either of the org and space may be empty to denote the targeted org or space.
+func ServerKeyFor(cliConnection plugin.CliConnection, org string, space string, serviceInstanceName string) (ServerKey, error) {
+	api, err := cliConnection.ApiEndpoint()
+	if err != nil {
+		return ServerKey{}, fmt.Errorf("API endpoint not available: %s", err)
+	}
+	user, err := cliConnection.Username()
+	if err != nil {
+		return ServerKey{}, fmt.Errorf("Username not available: %s", err)
+	}
+	if org == "" {
+		currentOrg, err := cliConnection.GetCurrentOrg()
+		if err != nil {
+			return ServerKey{}, fmt.Errorf("Targeted org not available: %s", err)
+		}
+		org = currentOrg.Name
+	}
+	if space == "" {
+		currentSpace, err := cliConnection.GetCurrentSpace()
+		if err != nil {
+			return ServerKey{}, fmt.Errorf("Targeted space not available: %s", err)
+		}
+		space = currentSpace.Name
+	}
+	return ServerKey{Api: api, User: user, Org: org, Space: space, ServiceInstance: serviceInstanceName}, nil
+}
+
+// CachedServer holds the server URLs of a service instance and, if it has been fetched, the body of the response
+// to a request for the dataflow server's /about resource.
+type CachedServer struct {
+	ServerUrls
+	About   json.RawMessage `json:",omitempty"`
+	Expires time.Time
+
+	// Cached is true if the entry was obtained from the cache rather than resolved.
+	Cached bool `json:"-"`
+}
+
+// ServerCache caches the server URLs of service instances for a limited time so that commands need not resolve
+// them each time they are run. Entries with an empty service instance name are never cached.
+//
+//go:generate counterfeiter -o serviceutilfakes/fake_server_cache.go . ServerCache
+type ServerCache interface {
+	// Get returns the unexpired entry with the given key, if there is one.
+	Get(key ServerKey) (*CachedServer, bool)
+
+	// Put stores an entry with the given key which expires after the cache's time to live.
+	Put(key ServerKey, server CachedServer) error
+
+	// Invalidate removes the entry with the given key, for example because a request to its server failed.
+	Invalidate(key ServerKey) error
+}
+
+// ResolveServer returns the cached entry for the service instance with the given key or, if there is none, resolves
+// the server URLs of the service instance in the key's org and space, as in ResolveServerUrlsInSpace, and caches them.
+// Failure to update the cache is ignored since the cache is only an optimisation.
+func ResolveServer(ctx context.Context, cliConnection plugin.CliConnection, serverCache ServerCache, key ServerKey, accessToken string, authClient httpclient.AuthenticatedClient) (*CachedServer, error) {
+	return resolveServer(serverCache, key, func() (*ServerUrls, error) {
+		return ResolveServerUrlsInSpace(ctx, cliConnection, key.Org, key.Space, key.ServiceInstance, accessToken, authClient)
+	})
+}
+
+// ResolveServiceInstanceServer is like ResolveServer but, if there is no cached entry, resolves the server URLs of a
+// service instance which has already been found, as in ResolveServiceInstanceUrls.
+func ResolveServiceInstanceServer(ctx context.Context, cliConnection plugin.CliConnection, serverCache ServerCache, key ServerKey, serviceInstance *ServiceInstance, accessToken string, authClient httpclient.AuthenticatedClient) (*CachedServer, error) {
+	return resolveServer(serverCache, key, func() (*ServerUrls, error) {
+		return ResolveServiceInstanceUrls(ctx, cliConnection, serviceInstance, accessToken, authClient)
+	})
+}
+
+func resolveServer(serverCache ServerCache, key ServerKey, resolve func() (*ServerUrls, error)) (*CachedServer, error) {
+	if server, ok := serverCache.Get(key); ok {
+		return server, nil
+	}
+
+	serverUrls, err := resolve()
+	if err != nil {
+		return nil, err
+	}
+	server := CachedServer{ServerUrls: *serverUrls}
+	_ = serverCache.Put(key, server)
+	return &server, nil
+}
+
+type serverCache struct {
+	cacheFile string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+// NewServerCache returns a cache stored in the given file whose entries expire after the given time to live. A zero
+// time to live disables the cache.
+func NewServerCache(cacheFile string, ttl time.Duration) *serverCache {
+	return &serverCache{
+		cacheFile: cacheFile,
+		ttl:       ttl,
+		now:       time.Now,
+	}
+}
+
+// ServerCacheFromEnvironment returns a cache stored alongside the shell JAR cache whose time to live is given by the
+// SCDF_SERVER_CACHE_TTL environment variable, either as a duration, such as "5m", or a whole number of seconds. The
+// default time to live is 10 minutes and a value of 0 disables the cache.
+func ServerCacheFromEnvironment() (*serverCache, error) {
+	ttl, err := cli.DurationFromEnvironment(ServerCacheTtlEnvVar, defaultServerCacheTtl, "5m")
+	if err != nil {
+		return nil, err
+	}
+
+	dir := os.Getenv(cfHomeEnvVar)
+	if dir == "" {
+		dir = os.Getenv(homeEnvVar)
+	}
+	return NewServerCache(path.Join(dir, cfDataDirectory, scdfDataDirectory, serverCacheFileName), ttl), nil
+}
+
+func (c *serverCache) Get(key ServerKey) (*CachedServer, bool) {
+	if c.ttl == 0 || key.ServiceInstance == "" {
+		return nil, false
+	}
+	entries := c.read()
+	server, ok := entries[key.String()]
+	if !ok || !c.now().Before(server.Expires) {
+		return nil, false
+	}
+	server.Cached = true
+	return &server, true
+}
+
+func (c *serverCache) Put(key ServerKey, server CachedServer) error {
+	if c.ttl == 0 || key.ServiceInstance == "" {
+		return nil
+	}
+	entries := c.read()
+	now := c.now()
+	for k, entry := range entries {
+		if !now.Before(entry.Expires) {
+			delete(entries, k)
+		}
+	}
+	server.Expires = now.Add(c.ttl)
+	entries[key.String()] = server
+	return c.write(entries)
+}
+
+func (c *serverCache) Invalidate(key ServerKey) error {
+	if c.ttl == 0 || key.ServiceInstance == "" {
+		return nil
+	}
+	entries := c.read()
+	if _, ok := entries[key.String()]; !ok {
+		return nil
+	}
+	delete(entries, key.String())
+	return c.write(entries)
+}
+
+// read returns the entries of the cache. A missing or corrupt cache file is treated as empty.
+func (c *serverCache) read() map[string]CachedServer {
+	entries := map[string]CachedServer{}
+	bytes, err := ioutil.ReadFile(c.cacheFile)
+	if err != nil {
+		return entries
+	}
+	if err := json.Unmarshal(bytes, &entries); err != nil {
+		return map[string]CachedServer{}
+	}
+	return entries
+}
+
+// write replaces the cache file so that a concurrent reader never sees a partially written file.
+func (c *serverCache) write(entries map[string]CachedServer) error {
+	bytes, err := json.Marshal(entries)
+	if err != nil {
+		return err // Should never get here
+	}
+	if err := os.MkdirAll(path.Dir(c.cacheFile), serverCacheDirPerm); err != nil {
+		return err
+	}
+	tempFile := c.cacheFile + ".tmp"
+	if err := ioutil.WriteFile(tempFile, bytes, serverCacheFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tempFile, c.cacheFile)
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil/serviceutilfakes"
+)
+
+var _ = Describe("ServerCache", func() {
+	var (
+		tempDir   string
+		cacheFile string
+		cache     serviceutil.ServerCache
+		key       serviceutil.ServerKey
+		server    serviceutil.CachedServer
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "server-cache")
+		Expect(err).NotTo(HaveOccurred())
+		cacheFile = filepath.Join(tempDir, "scdf", "servers.json")
+		cache = serviceutil.NewServerCache(cacheFile, time.Hour)
+		key = serviceutil.ServerKey{Api: "https://api.example.com", User: "user", Org: "org", Space: "space", ServiceInstance: "dataflow"}
+		server = serviceutil.CachedServer{
+			ServerUrls: serviceutil.ServerUrls{DataflowUrl: "https://dataflow", Method: serviceutil.ResolvedFromServiceKey},
+			About:      json.RawMessage(`{"versionInfo":{}}`),
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	It("should miss when the cache file does not exist", func() {
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should return a stored entry", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		cached, ok := cache.Get(key)
+		Expect(ok).To(BeTrue())
+		Expect(cached.DataflowUrl).To(Equal("https://dataflow"))
+		Expect(cached.Method).To(Equal(serviceutil.ResolvedFromServiceKey))
+		Expect(string(cached.About)).To(MatchJSON(`{"versionInfo":{}}`))
+		Expect(cached.Cached).To(BeTrue())
+		Expect(cached.Expires).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("should distinguish entries by space", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		otherKey := key
+		otherKey.Space = "other"
+		_, ok := cache.Get(otherKey)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should distinguish entries by user", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		otherKey := key
+		otherKey.User = "other"
+		_, ok := cache.Get(otherKey)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should make the cache file readable only by its owner", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		info, err := os.Stat(cacheFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should persist entries across cache instances", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		_, ok := serviceutil.NewServerCache(cacheFile, time.Hour).Get(key)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should miss when an entry has expired", func() {
+		expired := server
+		expired.Expires = time.Now().Add(-time.Second)
+		bytes, err := json.Marshal(map[string]serviceutil.CachedServer{key.String(): expired})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.MkdirAll(filepath.Dir(cacheFile), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(cacheFile, bytes, 0644)).To(Succeed())
+
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should treat a corrupt cache file as empty", func() {
+		Expect(os.MkdirAll(filepath.Dir(cacheFile), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(cacheFile, []byte("{"), 0644)).To(Succeed())
+
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+		Expect(cache.Put(key, server)).To(Succeed())
+		_, ok = cache.Get(key)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should invalidate an entry", func() {
+		Expect(cache.Put(key, server)).To(Succeed())
+		Expect(cache.Invalidate(key)).To(Succeed())
+
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not cache when the time to live is zero", func() {
+		cache = serviceutil.NewServerCache(cacheFile, 0)
+		Expect(cache.Put(key, server)).To(Succeed())
+
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+		Expect(cacheFile).NotTo(BeAnExistingFile())
+	})
+
+	It("should not cache entries without a service instance name", func() {
+		Expect(cache.Put(serviceutil.ServerKey{}, server)).To(Succeed())
+		Expect(cacheFile).NotTo(BeAnExistingFile())
+	})
+})
+
+var _ = Describe("ServerCacheFromEnvironment", func() {
+	var savedTtl string
+
+	BeforeEach(func() {
+		savedTtl = os.Getenv(serviceutil.ServerCacheTtlEnvVar)
+	})
+
+	AfterEach(func() {
+		os.Setenv(serviceutil.ServerCacheTtlEnvVar, savedTtl)
+	})
+
+	It("should reject an invalid time to live", func() {
+		os.Setenv(serviceutil.ServerCacheTtlEnvVar, "soon")
+		_, err := serviceutil.ServerCacheFromEnvironment()
+		Expect(err).To(MatchError("Invalid value 'soon' of SCDF_SERVER_CACHE_TTL: expected a duration such as 5m or a number of seconds"))
+	})
+
+	It("should accept a number of seconds", func() {
+		os.Setenv(serviceutil.ServerCacheTtlEnvVar, "30")
+		_, err := serviceutil.ServerCacheFromEnvironment()
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("ResolveServer", func() {
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		fakeCache         *serviceutilfakes.FakeServerCache
+		key               serviceutil.ServerKey
+		server            *serviceutil.CachedServer
+		err               error
+	)
+
+	BeforeEach(func() {
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{Guid: "guid"}, nil)
+		fakeCliConnection.CliCommandWithoutTerminalOutputReturns([]string{`{"resources": [{"entity": {"credentials": {"dataflow-url": "https://dataflow"}}}]}`}, nil)
+		fakeCache = &serviceutilfakes.FakeServerCache{}
+		key = serviceutil.ServerKey{ServiceInstance: "dataflow"}
+	})
+
+	JustBeforeEach(func() {
+		server, err = serviceutil.ResolveServer(context.Background(), fakeCliConnection, fakeCache, key, "access-token", &httpclientfakes.FakeAuthenticatedClient{})
+	})
+
+	Context("when the server is cached", func() {
+		BeforeEach(func() {
+			fakeCache.GetReturns(&serviceutil.CachedServer{ServerUrls: serviceutil.ServerUrls{DataflowUrl: "https://cached"}, Cached: true}, true)
+		})
+
+		It("should return the cached server without resolving it", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.DataflowUrl).To(Equal("https://cached"))
+			Expect(fakeCache.GetArgsForCall(0)).To(Equal(key))
+			Expect(fakeCliConnection.GetServiceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the server is not cached", func() {
+		It("should resolve and cache the server", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.DataflowUrl).To(Equal("https://dataflow"))
+			Expect(server.Cached).To(BeFalse())
+			Expect(fakeCache.PutCallCount()).To(Equal(1))
+			putKey, putServer := fakeCache.PutArgsForCall(0)
+			Expect(putKey).To(Equal(key))
+			Expect(putServer.DataflowUrl).To(Equal("https://dataflow"))
+		})
+
+		Context("when the cache cannot be updated", func() {
+			BeforeEach(func() {
+				fakeCache.PutReturns(errors.New("disk full"))
+			})
+
+			It("should ignore the failure", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.DataflowUrl).To(Equal("https://dataflow"))
+			})
+		})
+	})
+
+	Context("when the server cannot be resolved", func() {
+		BeforeEach(func() {
+			fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{}, errors.New("no such service"))
+		})
+
+		It("should not cache anything", func() {
+			Expect(err).To(MatchError("Service instance not found: no such service"))
+			Expect(fakeCache.PutCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,211 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+const userProvidedServiceInstanceType = "user_provided_service_instance"
+
+// ServiceInstance is the subset of the details of a service instance which are needed to resolve its server URLs.
+type ServiceInstance struct {
+	Guid           string
+	Name           string
+	DashboardUrl   string
+	IsUserProvided bool
+
+	// Operation is the last operation on the service instance, if known.
+	Operation Operation
+}
+
+// Operation describes an operation on a service instance, such as its creation, and its state.
+type Operation struct {
+	Type        string
+	State       string
+	Description string
+}
+
+type resourcesResp struct {
+	NextUrl   string     `json:"next_url"`
+	Resources []resource `json:"resources"`
+}
+
+// resource holds the fields of the Cloud Controller resources, such as orgs, spaces, services, service plans, and
+// service instances, which are used by this package.
+type resource struct {
+	Metadata struct {
+		Guid string `json:"guid"`
+	} `json:"metadata"`
+	Entity struct {
+		Name             string `json:"name"`
+		Label            string `json:"label"`
+		DashboardUrl     string `json:"dashboard_url"`
+		Type             string `json:"type"`
+		ServicePlanGuid  string `json:"service_plan_guid"`
+		SpaceGuid        string `json:"space_guid"`
+		OrganizationGuid string `json:"organization_guid"`
+		LastOperation    struct {
+			Type        string `json:"type"`
+			State       string `json:"state"`
+			Description string `json:"description"`
+		} `json:"last_operation"`
+	} `json:"entity"`
+}
+
+// FindServiceInstance finds the service instance with the given name in the given org and space. If the org or space
+// is empty, the name of the targeted org or space is used instead. If the org and space are those targeted, the cf CLI's
+// view of the service instance is used. Otherwise the service instance is found using the Cloud Controller API,
+// including service instances shared into the space from other spaces. The user's target is not changed.
+func FindServiceInstance(cliConnection plugin.CliConnection, org string, space string, name string) (*ServiceInstance, error) {
+	targeted, err := isTargeted(cliConnection, org, space)
+	if err != nil {
+		return nil, err
+	}
+	if targeted {
+		serviceModel, err := cliConnection.GetService(name)
+		if err != nil {
+			return nil, fmt.Errorf("Service instance not found: %s", err)
+		}
+		return &ServiceInstance{
+			Guid:           serviceModel.Guid,
+			Name:           name,
+			DashboardUrl:   serviceModel.DashboardUrl,
+			IsUserProvided: serviceModel.IsUserProvided,
+			Operation: Operation{
+				Type:        serviceModel.LastOperation.Type,
+				State:       serviceModel.LastOperation.State,
+				Description: serviceModel.LastOperation.Description,
+			},
+		}, nil
+	}
+
+	if org == "" {
+		currentOrg, err := cliConnection.GetCurrentOrg()
+		if err != nil {
+			return nil, fmt.Errorf("Targeted org not available: %s", err)
+		}
+		org = currentOrg.Name
+	}
+
+	orgGuid, err := findGuid(cliConnection, "/v2/organizations?q="+url.QueryEscape("name:"+org), fmt.Sprintf("Org %s not found", org))
+	if err != nil {
+		return nil, err
+	}
+
+	if space == "" {
+		currentSpace, err := cliConnection.GetCurrentSpace()
+		if err != nil {
+			return nil, fmt.Errorf("Targeted space not available: %s", err)
+		}
+		space = currentSpace.Name
+	}
+	spaceGuid, err := findGuid(cliConnection, fmt.Sprintf("/v2/organizations/%s/spaces?q=%s", orgGuid, url.QueryEscape("name:"+space)), fmt.Sprintf("Space %s not found in org %s", space, org))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := curl(cliConnection, fmt.Sprintf("/v2/spaces/%s/service_instances?return_user_provided_service_instances=true&q=%s", spaceGuid, url.QueryEscape("name:"+name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Resources) == 0 {
+		return nil, fmt.Errorf("Service instance %s not found in org %s / space %s", name, org, space)
+	}
+	resource := resp.Resources[0]
+	lastOperation := resource.Entity.LastOperation
+	return &ServiceInstance{
+		Guid:           resource.Metadata.Guid,
+		Name:           resource.Entity.Name,
+		DashboardUrl:   resource.Entity.DashboardUrl,
+		IsUserProvided: resource.Entity.Type == userProvidedServiceInstanceType,
+		Operation:      Operation{Type: lastOperation.Type, State: lastOperation.State, Description: lastOperation.Description},
+	}, nil
+}
+
+// isTargeted returns true if the given org and space, either of which may be empty, are those targeted.
+func isTargeted(cliConnection plugin.CliConnection, org string, space string) (bool, error) {
+	if org != "" {
+		currentOrg, err := cliConnection.GetCurrentOrg()
+		if err != nil {
+			return false, fmt.Errorf("Targeted org not available: %s", err)
+		}
+		if currentOrg.Name != org {
+			return false, nil
+		}
+	}
+	if space != "" {
+		currentSpace, err := cliConnection.GetCurrentSpace()
+		if err != nil {
+			return false, fmt.Errorf("Targeted space not available: %s", err)
+		}
+		if currentSpace.Name != space {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func findGuid(cliConnection plugin.CliConnection, path string, notFound string) (string, error) {
+	resp, err := curl(cliConnection, path)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Resources) == 0 {
+		return "", fmt.Errorf("%s", notFound)
+	}
+	return resp.Resources[0].Metadata.Guid, nil
+}
+
+func curl(cliConnection plugin.CliConnection, path string) (*resourcesResp, error) {
+	var resp resourcesResp
+	if err := curlInto(cliConnection, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// curlInto decodes the response to a Cloud Controller request for the given path into the given result.
+func curlInto(cliConnection plugin.CliConnection, path string, result interface{}) error {
+	output, err := cliConnection.CliCommandWithoutTerminalOutput("curl", path)
+	if err != nil {
+		return fmt.Errorf("Cloud Controller request %s failed: %s", path, err)
+	}
+	if err := json.Unmarshal([]byte(strings.Join(output, "\n")), result); err != nil {
+		return fmt.Errorf("Invalid Cloud Controller response to %s: %s", path, err)
+	}
+	return nil
+}
+
+// curlAll returns the resources of all the pages of the Cloud Controller collection at the given path.
+func curlAll(cliConnection plugin.CliConnection, path string) ([]resource, error) {
+	resources := []resource{}
+	for path != "" {
+		resp, err := curl(cliConnection, path)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resp.Resources...)
+		path = resp.NextUrl
+	}
+	return resources, nil
+}
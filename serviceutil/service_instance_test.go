@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviceutil_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/plugin/pluginfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+)
+
+var _ = Describe("FindServiceInstance", func() {
+	const (
+		orgsPath      = "/v2/organizations?q=name%3Aother-org"
+		spacesPath    = "/v2/organizations/org-guid/spaces?q=name%3Aother-space"
+		instancesPath = "/v2/spaces/space-guid/service_instances?return_user_provided_service_instances=true&q=name%3Adataflow"
+	)
+
+	var (
+		fakeCliConnection *pluginfakes.FakeCliConnection
+		curlOutput        map[string][]string
+		org               string
+		space             string
+		serviceInstance   *serviceutil.ServiceInstance
+		err               error
+	)
+
+	BeforeEach(func() {
+		fakeCliConnection = &pluginfakes.FakeCliConnection{}
+		fakeCliConnection.GetCurrentOrgReturns(plugin_models.Organization{OrganizationFields: plugin_models.OrganizationFields{Name: "org", Guid: "current-org-guid"}}, nil)
+		fakeCliConnection.GetCurrentSpaceReturns(plugin_models.Space{SpaceFields: plugin_models.SpaceFields{Name: "space", Guid: "current-space-guid"}}, nil)
+		fakeCliConnection.GetServiceReturns(plugin_models.GetService_Model{Guid: "guid", Name: "dataflow", DashboardUrl: "https://broker/dashboard"}, nil)
+		curlOutput = map[string][]string{
+			orgsPath:   {`{"resources": [{"metadata": {"guid": "org-guid"}}]}`},
+			spacesPath: {`{"resources": [{"metadata": {"guid": "space-guid"}}]}`},
+			instancesPath: {`{"resources": [{"metadata": {"guid": "shared-guid"},`,
+				`"entity": {"name": "dataflow", "dashboard_url": "https://broker/instances/shared-guid/dashboard", "type": "managed_service_instance"}}]}`},
+		}
+		fakeCliConnection.CliCommandWithoutTerminalOutputStub = func(args ...string) ([]string, error) {
+			output, ok := curlOutput[args[1]]
+			if !ok {
+				return nil, errors.New("unexpected request " + args[1])
+			}
+			return output, nil
+		}
+		org = ""
+		space = ""
+	})
+
+	JustBeforeEach(func() {
+		serviceInstance, err = serviceutil.FindServiceInstance(fakeCliConnection, org, space, "dataflow")
+	})
+
+	Context("when the targeted space is used", func() {
+		BeforeEach(func() {
+			org = "org"
+		})
+
+		It("should use the cf CLI's view of the service instance", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*serviceInstance).To(Equal(serviceutil.ServiceInstance{Guid: "guid", Name: "dataflow", DashboardUrl: "https://broker/dashboard"}))
+			Expect(fakeCliConnection.CliCommandWithoutTerminalOutputCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when another org and space are given", func() {
+		BeforeEach(func() {
+			org = "other-org"
+			space = "other-space"
+		})
+
+		It("should find the service instance using the Cloud Controller API", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*serviceInstance).To(Equal(serviceutil.ServiceInstance{
+				Guid:         "shared-guid",
+				Name:         "dataflow",
+				DashboardUrl: "https://broker/instances/shared-guid/dashboard",
+			}))
+			Expect(fakeCliConnection.GetServiceCallCount()).To(Equal(0))
+		})
+
+		It("should not change the target", func() {
+			for i := 0; i < fakeCliConnection.CliCommandWithoutTerminalOutputCallCount(); i++ {
+				Expect(fakeCliConnection.CliCommandWithoutTerminalOutputArgsForCall(i)[0]).To(Equal("curl"))
+			}
+		})
+
+		Context("when the service instance is user-provided", func() {
+			BeforeEach(func() {
+				curlOutput[instancesPath] = []string{`{"resources": [{"metadata": {"guid": "ups-guid"}, "entity": {"name": "dataflow", "type": "user_provided_service_instance"}}]}`}
+			})
+
+			It("should report that it is user-provided", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance.IsUserProvided).To(BeTrue())
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				curlOutput[orgsPath] = []string{`{"resources": []}`}
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("Org other-org not found"))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				curlOutput[spacesPath] = []string{`{"resources": []}`}
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("Space other-space not found in org other-org"))
+			})
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				curlOutput[instancesPath] = []string{`{"resources": []}`}
+			})
+
+			It("should return a suitable error", func() {
+				Expect(err).To(MatchError("Service instance dataflow not found in org other-org / space other-space"))
+			})
+		})
+	})
+
+	Context("when only another space is given", func() {
+		BeforeEach(func() {
+			space = "other-space"
+			curlOutput["/v2/organizations?q=name%3Aorg"] = []string{`{"resources": [{"metadata": {"guid": "org-guid"}}]}`}
+		})
+
+		It("should look for the space in the targeted org", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance.Guid).To(Equal("shared-guid"))
+		})
+	})
+
+	Context("when only another org is given", func() {
+		BeforeEach(func() {
+			org = "other-org"
+			curlOutput["/v2/organizations/org-guid/spaces?q=name%3Aspace"] = curlOutput[spacesPath]
+		})
+
+		It("should look in the space with the targeted space's name", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance.Guid).To(Equal("shared-guid"))
+		})
+	})
+})
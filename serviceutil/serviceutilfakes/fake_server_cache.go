@@ -0,0 +1,266 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package serviceutilfakes
+
+import (
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
+)
+
+type FakeServerCache struct {
+	GetStub        func(serviceutil.ServerKey) (*serviceutil.CachedServer, bool)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 serviceutil.ServerKey
+	}
+	getReturns struct {
+		result1 *serviceutil.CachedServer
+		result2 bool
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *serviceutil.CachedServer
+		result2 bool
+	}
+	InvalidateStub        func(serviceutil.ServerKey) error
+	invalidateMutex       sync.RWMutex
+	invalidateArgsForCall []struct {
+		arg1 serviceutil.ServerKey
+	}
+	invalidateReturns struct {
+		result1 error
+	}
+	invalidateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PutStub        func(serviceutil.ServerKey, serviceutil.CachedServer) error
+	putMutex       sync.RWMutex
+	putArgsForCall []struct {
+		arg1 serviceutil.ServerKey
+		arg2 serviceutil.CachedServer
+	}
+	putReturns struct {
+		result1 error
+	}
+	putReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServerCache) Get(arg1 serviceutil.ServerKey) (*serviceutil.CachedServer, bool) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 serviceutil.ServerKey
+	}{arg1})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServerCache) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeServerCache) GetCalls(stub func(serviceutil.ServerKey) (*serviceutil.CachedServer, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeServerCache) GetArgsForCall(i int) serviceutil.ServerKey {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServerCache) GetReturns(result1 *serviceutil.CachedServer, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *serviceutil.CachedServer
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeServerCache) GetReturnsOnCall(i int, result1 *serviceutil.CachedServer, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *serviceutil.CachedServer
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *serviceutil.CachedServer
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeServerCache) Invalidate(arg1 serviceutil.ServerKey) error {
+	fake.invalidateMutex.Lock()
+	ret, specificReturn := fake.invalidateReturnsOnCall[len(fake.invalidateArgsForCall)]
+	fake.invalidateArgsForCall = append(fake.invalidateArgsForCall, struct {
+		arg1 serviceutil.ServerKey
+	}{arg1})
+	stub := fake.InvalidateStub
+	fakeReturns := fake.invalidateReturns
+	fake.recordInvocation("Invalidate", []interface{}{arg1})
+	fake.invalidateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeServerCache) InvalidateCallCount() int {
+	fake.invalidateMutex.RLock()
+	defer fake.invalidateMutex.RUnlock()
+	return len(fake.invalidateArgsForCall)
+}
+
+func (fake *FakeServerCache) InvalidateCalls(stub func(serviceutil.ServerKey) error) {
+	fake.invalidateMutex.Lock()
+	defer fake.invalidateMutex.Unlock()
+	fake.InvalidateStub = stub
+}
+
+func (fake *FakeServerCache) InvalidateArgsForCall(i int) serviceutil.ServerKey {
+	fake.invalidateMutex.RLock()
+	defer fake.invalidateMutex.RUnlock()
+	argsForCall := fake.invalidateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServerCache) InvalidateReturns(result1 error) {
+	fake.invalidateMutex.Lock()
+	defer fake.invalidateMutex.Unlock()
+	fake.InvalidateStub = nil
+	fake.invalidateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServerCache) InvalidateReturnsOnCall(i int, result1 error) {
+	fake.invalidateMutex.Lock()
+	defer fake.invalidateMutex.Unlock()
+	fake.InvalidateStub = nil
+	if fake.invalidateReturnsOnCall == nil {
+		fake.invalidateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.invalidateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServerCache) Put(arg1 serviceutil.ServerKey, arg2 serviceutil.CachedServer) error {
+	fake.putMutex.Lock()
+	ret, specificReturn := fake.putReturnsOnCall[len(fake.putArgsForCall)]
+	fake.putArgsForCall = append(fake.putArgsForCall, struct {
+		arg1 serviceutil.ServerKey
+		arg2 serviceutil.CachedServer
+	}{arg1, arg2})
+	stub := fake.PutStub
+	fakeReturns := fake.putReturns
+	fake.recordInvocation("Put", []interface{}{arg1, arg2})
+	fake.putMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeServerCache) PutCallCount() int {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return len(fake.putArgsForCall)
+}
+
+func (fake *FakeServerCache) PutCalls(stub func(serviceutil.ServerKey, serviceutil.CachedServer) error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = stub
+}
+
+func (fake *FakeServerCache) PutArgsForCall(i int) (serviceutil.ServerKey, serviceutil.CachedServer) {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	argsForCall := fake.putArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServerCache) PutReturns(result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServerCache) PutReturnsOnCall(i int, result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	if fake.putReturnsOnCall == nil {
+		fake.putReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServerCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.invalidateMutex.RLock()
+	defer fake.invalidateMutex.RUnlock()
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServerCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ serviceutil.ServerCache = new(FakeServerCache)
@@ -17,6 +17,7 @@
 package serviceutil
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -28,20 +29,20 @@ import (
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
 )
 
-type serviceDefinitionResp struct {
-	Credentials struct {
-		URI string
-	}
-}
-
-// ServiceInstanceURL obtains the service instance URL of a service with a specific name. This is a secure operation and an access token is provided for authentication and authorisation.
-func ServiceInstanceURL(cliConnection plugin.CliConnection, serviceInstanceName string, accessToken string, authClient httpclient.AuthenticatedClient) (string, error) {
+// ServiceInstanceURL obtains the service instance URL of a service with a specific name. This is a secure operation and an access token is provided for authentication and authorisation. The request to the service broker is cancelled if the given context is done.
+func ServiceInstanceURL(ctx context.Context, cliConnection plugin.CliConnection, serviceInstanceName string, accessToken string, authClient httpclient.AuthenticatedClient) (string, error) {
 	serviceModel, err := cliConnection.GetService(serviceInstanceName)
 	if err != nil {
 		return "", fmt.Errorf("Service instance not found: %s", err)
 	}
 
-	parsedUrl, err := url.Parse(serviceModel.DashboardUrl)
+	return brokerRedirectURL(ctx, serviceModel.DashboardUrl, accessToken, authClient)
+}
+
+// brokerRedirectURL obtains a service instance URL from the service broker, which redirects requests for the parent
+// of the dashboard URL to the service instance.
+func brokerRedirectURL(ctx context.Context, dashboardUrl string, accessToken string, authClient httpclient.AuthenticatedClient) (string, error) {
+	parsedUrl, err := url.Parse(dashboardUrl)
 	if err != nil {
 		return "", err
 	}
@@ -49,15 +50,15 @@ func ServiceInstanceURL(cliConnection plugin.CliConnection, serviceInstanceName
 
 	segments := strings.Split(path, "/")
 	if len(segments) == 0 || (len(segments) == 1 && segments[0] == "") {
-		return "", fmt.Errorf("path of %s has no segments", serviceModel.DashboardUrl)
+		return "", fmt.Errorf("path of %s has no segments", dashboardUrl)
 	}
 
 	parsedUrl.Path = strings.Join(segments[:len(segments)-1], "/")
 
-	_, statusCode, header, err := authClient.DoAuthenticatedGet(parsedUrl.String(), accessToken)
+	_, statusCode, header, err := authClient.DoAuthenticatedGetContext(ctx, parsedUrl.String(), accessToken)
 	if statusCode != http.StatusFound {
 		if err != nil {
-			return "", fmt.Errorf("service broker failed: %s", err)
+			return "", fmt.Errorf("service broker failed: %w", err)
 		}
 		return "", fmt.Errorf("service broker did not return expected response (302): %d", statusCode)
 	}
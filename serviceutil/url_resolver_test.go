@@ -17,6 +17,8 @@
 package serviceutil_test
 
 import (
+	"context"
+
 	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/serviceutil"
 
 	"errors"
@@ -52,7 +54,7 @@ var _ = Describe("ServiceInstanceURL", func() {
 	})
 
 	JustBeforeEach(func() {
-		serviceInstanceURL, err = serviceutil.ServiceInstanceURL(fakeCliConnection, serviceInstanceName, accessToken, authClient)
+		serviceInstanceURL, err = serviceutil.ServiceInstanceURL(context.Background(), fakeCliConnection, serviceInstanceName, accessToken, authClient)
 	})
 
 	It("should get the service", func() {
@@ -106,15 +108,15 @@ var _ = Describe("ServiceInstanceURL", func() {
 		})
 
 		It("should issue a get to the broker", func() {
-			Expect(authClient.DoAuthenticatedGetCallCount()).To(Equal(1))
-			url, token := authClient.DoAuthenticatedGetArgsForCall(0)
+			Expect(authClient.DoAuthenticatedGetContextCallCount()).To(Equal(1))
+			_, url, token := authClient.DoAuthenticatedGetContextArgsForCall(0)
 			Expect(url).To(Equal("https://spring-cloud-broker.some.host.name/instances/guid"))
 			Expect(token).To(Equal(accessToken))
 		})
 
 		Context("when the broker cannot be contacted", func() {
 			BeforeEach(func() {
-				authClient.DoAuthenticatedGetReturns(nil, http.StatusBadGateway, http.Header{}, testError)
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusBadGateway, http.Header{}, testError)
 			})
 
 			It("should return a suitable error", func() {
@@ -125,7 +127,7 @@ var _ = Describe("ServiceInstanceURL", func() {
 
 		Context("when the broker returns status ok", func() {
 			BeforeEach(func() {
-				authClient.DoAuthenticatedGetReturns(nil, http.StatusOK, http.Header{}, nil)
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusOK, http.Header{}, nil)
 			})
 
 			It("should return a suitable error", func() {
@@ -136,7 +138,7 @@ var _ = Describe("ServiceInstanceURL", func() {
 
 		Context("when the broker returns a redirect without a location header", func() {
 			BeforeEach(func() {
-				authClient.DoAuthenticatedGetReturns(nil, http.StatusFound, http.Header{}, nil)
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusFound, http.Header{}, nil)
 			})
 
 			It("should return a suitable error", func() {
@@ -147,7 +149,7 @@ var _ = Describe("ServiceInstanceURL", func() {
 
 		Context("when the broker returns a redirect with a location header with the wrong number of items", func() {
 			BeforeEach(func() {
-				authClient.DoAuthenticatedGetReturns(nil, http.StatusFound, http.Header{"Location": []string{}}, nil)
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusFound, http.Header{"Location": []string{}}, nil)
 			})
 
 			It("should return a suitable error", func() {
@@ -158,7 +160,7 @@ var _ = Describe("ServiceInstanceURL", func() {
 
 		Context("when the broker returns a redirect with a location header with one item", func() {
 			BeforeEach(func() {
-				authClient.DoAuthenticatedGetReturns(nil, http.StatusFound, http.Header{"Location": []string{"https://dataflow-server-url"}}, nil)
+				authClient.DoAuthenticatedGetContextReturns(nil, http.StatusFound, http.Header{"Location": []string{"https://dataflow-server-url"}}, nil)
 			})
 
 			It("should return a suitable error", func() {
@@ -0,0 +1,46 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper
+
+import "context"
+
+// AboutInfo describes a Skipper server and its version.
+type AboutInfo struct {
+	VersionInfo VersionInfo `json:"versionInfo"`
+}
+
+type VersionInfo struct {
+	Server Dependency `json:"server"`
+	Shell  Dependency `json:"shell"`
+}
+
+// Dependency describes a component of a Skipper server. Checksums are provided only for downloadable components.
+type Dependency struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	Url            string `json:"url"`
+	ChecksumSha1   string `json:"checksumSha1"`
+	ChecksumSha256 string `json:"checksumSha256"`
+}
+
+func (c *client) About(ctx context.Context) (*AboutInfo, error) {
+	var about AboutInfo
+	if err := c.api.Get(ctx, "about", nil, &about); err != nil {
+		return nil, err
+	}
+	return &about, nil
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// Client is a client for the REST API of a Skipper server. Its server URL is the URL of the API's root resource,
+// the same URL at which the server's /about resource is found.
+//
+//go:generate counterfeiter -o skipperfakes/fake_client.go . Client
+type Client interface {
+	// ServerUrl returns the URL of the Skipper server's API.
+	ServerUrl() string
+
+	// About returns information about the server and its version.
+	About(ctx context.Context) (*AboutInfo, error)
+
+	// Packages returns the metadata of the packages whose names contain the given search string or, if the string is
+	// empty, of all packages.
+	Packages(ctx context.Context, search string) ([]PackageMetadata, error)
+
+	// UploadPackage uploads a package to a local repository.
+	UploadPackage(ctx context.Context, upload UploadRequest) (*PackageMetadata, error)
+
+	// DeletePackage deletes the package with the given name from local repositories.
+	DeletePackage(ctx context.Context, name string) error
+
+	// Repositories returns the package repositories known to the server.
+	Repositories(ctx context.Context) ([]Repository, error)
+
+	// Releases returns the latest releases whose names contain the given string or, if the string is empty, all
+	// latest releases.
+	Releases(ctx context.Context, name string) ([]Release, error)
+
+	// Install installs a package, creating a release.
+	Install(ctx context.Context, install InstallRequest) (*Release, error)
+
+	// Upgrade upgrades a release, creating a new release version.
+	Upgrade(ctx context.Context, upgrade UpgradeRequest) (*Release, error)
+
+	// Rollback rolls back the release with the given name to the given version, or to the previous version if
+	// version is zero.
+	Rollback(ctx context.Context, name string, version int) (*Release, error)
+
+	// DeleteRelease deletes the release with the given name and, if deletePackage is true, its package.
+	DeleteRelease(ctx context.Context, name string, deletePackage bool) error
+
+	// Status returns the status of the given version of the release with the given name, or of the latest version
+	// if version is zero.
+	Status(ctx context.Context, name string, version int) (*Info, error)
+
+	// History returns the versions of the release with the given name, most recent first.
+	History(ctx context.Context, name string) ([]Release, error)
+
+	// Manifest returns the manifest of the given version of the release with the given name, or of the latest
+	// version if version is zero.
+	Manifest(ctx context.Context, name string, version int) (string, error)
+
+	// Deployers returns the deployers, one for each platform account, configured in the server.
+	Deployers(ctx context.Context) ([]Deployer, error)
+
+	// Platforms returns the platform accounts to which releases may be deployed.
+	Platforms(ctx context.Context) ([]Platform, error)
+}
+
+// links maps the relations of links in the server's root resource to the URI templates used by servers whose
+// root resource does not provide the link.
+var links = map[string]string{
+	"about":                         "/about",
+	"packageMetadata":               "/packageMetadata",
+	"packageMetadata/search":        "/packageMetadata/search/findByNameContainingIgnoreCase{?name}",
+	"package/upload":                "/package/upload",
+	"package/install":               "/package/install",
+	"package/delete":                "/package/{name}",
+	"repositories":                  "/repositories",
+	"release/list":                  "/release/list",
+	"release/list/name":             "/release/list/{name}",
+	"release/upgrade":               "/release/upgrade",
+	"release/rollback":              "/release/rollback",
+	"release/delete":                "/release/{name}",
+	"release/status/name":           "/release/status/{name}",
+	"release/status/name/version":   "/release/status/{name}/{version}",
+	"release/history/name":          "/release/history/{name}",
+	"release/manifest/name":         "/release/manifest/{name}",
+	"release/manifest/name/version": "/release/manifest/{name}/{version}",
+	"deployers":                     "/deployers",
+}
+
+type client struct {
+	hal hal.Client
+	api *hal.Api
+}
+
+// NewClient returns a client for the Skipper server whose API is at the given URL.
+func NewClient(authClient httpclient.AuthenticatedClient, serverUrl string, accessToken string) *client {
+	return NewHalClient(hal.NewClient(authClient, serverUrl, accessToken))
+}
+
+// NewHalClient returns a client for the Skipper server accessed by the given HAL client.
+func NewHalClient(halClient hal.Client) *client {
+	return &client{hal: halClient, api: hal.NewApi(halClient, links)}
+}
+
+func (c *client) ServerUrl() string {
+	return c.hal.ServerUrl()
+}
+
+// versioned selects between a relation for the latest version of a release and one for a specific version.
+func versioned(rel string, name string, version int) (string, map[string]string) {
+	if version == 0 {
+		return rel + "/name", map[string]string{"name": name}
+	}
+	return rel + "/name/version", map[string]string{"name": name, "version": strconv.Itoa(version)}
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient/httpclientfakes"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/skipper"
+)
+
+var _ = Describe("Client", func() {
+	const (
+		serverUrl   = "https://skipper.example.com/api"
+		accessToken = "access-token"
+	)
+
+	var (
+		fakeAuthClient *httpclientfakes.FakeAuthenticatedClient
+		responses      map[string]string
+		requests       []*httpclient.Request
+		client         skipper.Client
+		ctx            context.Context
+		err            error
+	)
+
+	lastRequest := func() *httpclient.Request {
+		Expect(requests).NotTo(BeEmpty())
+		return requests[len(requests)-1]
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = nil
+		responses = map[string]string{
+			"GET " + serverUrl + "/": `{"_links": {
+				"about": {"href": "https://skipper.example.com/api/about"},
+				"release/status/name": {"href": "https://skipper.example.com/api/release/status/{name}", "templated": true}
+			}}`,
+		}
+
+		fakeAuthClient = &httpclientfakes.FakeAuthenticatedClient{}
+		fakeAuthClient.DoAuthenticatedStub = func(ctx context.Context, request *httpclient.Request, token string) (*http.Response, error) {
+			requests = append(requests, request)
+			body, ok := responses[request.Method()+" "+request.URL()]
+			if !ok {
+				return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found"}, errors.New("not found: " + request.Method() + " " + request.URL())
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}
+
+		client = skipper.NewClient(fakeAuthClient, serverUrl, accessToken)
+	})
+
+	It("should return the server version", func() {
+		responses["GET "+serverUrl+"/about"] = `{"versionInfo": {"server": {"name": "Spring Cloud Skipper Server", "version": "2.0.1.RELEASE"}}}`
+
+		about, err := client.About(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(about.VersionInfo.Server.Version).To(Equal("2.0.1.RELEASE"))
+	})
+
+	It("should search packages by name", func() {
+		responses["GET "+serverUrl+"/packageMetadata/search/findByNameContainingIgnoreCase?name=tick"] = `{"_embedded": {"packageMetadata": [
+			{"name": "ticktock", "version": "1.0.0", "repositoryName": "local"}
+		]}}`
+
+		packages, err := client.Packages(ctx, "tick")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(packages).To(HaveLen(1))
+		Expect(packages[0].Name).To(Equal("ticktock"))
+		Expect(packages[0].RepositoryName).To(Equal("local"))
+	})
+
+	It("should list repositories", func() {
+		responses["GET "+serverUrl+"/repositories"] = `{"_embedded": {"repositories": [
+			{"name": "local", "local": true, "repoOrder": 1}
+		]}}`
+
+		repositories, err := client.Repositories(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repositories).To(HaveLen(1))
+		Expect(repositories[0].Local).To(BeTrue())
+	})
+
+	It("should install a package", func() {
+		responses["POST "+serverUrl+"/package/install"] = `{"name": "ticktock", "version": 1, "info": {"status": {"statusCode": "DEPLOYED"}}}`
+
+		release, err := client.Install(ctx, skipper.InstallRequest{
+			PackageIdentifier: skipper.PackageIdentifier{PackageName: "ticktock", PackageVersion: "1.0.0"},
+			InstallProperties: skipper.InstallProperties{ReleaseName: "ticktock", PlatformName: "default", ConfigValues: skipper.ConfigValues{Raw: "a: b"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(release.Info.Status.StatusCode).To(Equal(skipper.StatusDeployed))
+		Expect(lastRequest().Body()).To(MatchJSON(`{
+			"packageIdentifier": {"packageName": "ticktock", "packageVersion": "1.0.0"},
+			"installProperties": {"releaseName": "ticktock", "platformName": "default", "configValues": {"raw": "a: b"}}
+		}`))
+	})
+
+	It("should upgrade a release", func() {
+		responses["POST "+serverUrl+"/release/upgrade"] = `{"name": "ticktock", "version": 2}`
+
+		release, err := client.Upgrade(ctx, skipper.UpgradeRequest{
+			PackageIdentifier: skipper.PackageIdentifier{PackageName: "ticktock"},
+			UpgradeProperties: skipper.UpgradeProperties{ReleaseName: "ticktock"},
+			Force:             true,
+			AppNames:          []string{"log"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(release.Version).To(Equal(2))
+		Expect(lastRequest().Body()).To(MatchJSON(`{
+			"packageIdentifier": {"packageName": "ticktock"},
+			"upgradeProperties": {"releaseName": "ticktock", "configValues": {}},
+			"force": true,
+			"appNames": ["log"]
+		}`))
+	})
+
+	It("should roll back a release", func() {
+		responses["POST "+serverUrl+"/release/rollback"] = `{"name": "ticktock", "version": 3}`
+
+		release, err := client.Rollback(ctx, "ticktock", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(release.Version).To(Equal(3))
+		Expect(lastRequest().Body()).To(MatchJSON(`{"releaseName": "ticktock", "version": 1}`))
+	})
+
+	It("should delete a release and its package", func() {
+		responses["DELETE "+serverUrl+"/release/ticktock?deletePackage=true"] = ``
+
+		Expect(client.DeleteRelease(ctx, "ticktock", true)).To(Succeed())
+	})
+
+	It("should return the status of the latest version of a release", func() {
+		responses["GET "+serverUrl+"/release/status/ticktock"] = `{"status": {"statusCode": "DEPLOYED", "platformStatus": "[]"}, "description": "Install complete"}`
+
+		info, err := client.Status(ctx, "ticktock", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Status.StatusCode).To(Equal(skipper.StatusDeployed))
+		Expect(info.Description).To(Equal("Install complete"))
+	})
+
+	It("should return the status of a specific version of a release", func() {
+		responses["GET "+serverUrl+"/release/status/ticktock/2"] = `{"status": {"statusCode": "DELETED"}}`
+
+		info, err := client.Status(ctx, "ticktock", 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Status.StatusCode).To(Equal(skipper.StatusDeleted))
+	})
+
+	It("should return the history of a release with the most recent version first", func() {
+		responses["GET "+serverUrl+"/release/history/ticktock"] = `{"_embedded": {"releases": [
+			{"name": "ticktock", "version": 1}, {"name": "ticktock", "version": 2}
+		]}}`
+
+		releases, err := client.History(ctx, "ticktock")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releases).To(HaveLen(2))
+		Expect(releases[0].Version).To(Equal(2))
+		Expect(releases[1].Version).To(Equal(1))
+	})
+
+	It("should return a manifest provided as text", func() {
+		responses["GET "+serverUrl+"/release/manifest/ticktock/2"] = "kind: SpringCloudDeployerApplication\n"
+
+		manifest, err := client.Manifest(ctx, "ticktock", 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest).To(Equal("kind: SpringCloudDeployerApplication\n"))
+	})
+
+	It("should return a manifest provided as a resource", func() {
+		responses["GET "+serverUrl+"/release/manifest/ticktock"] = `{"data": "kind: SpringCloudDeployerApplication\n"}`
+
+		manifest, err := client.Manifest(ctx, "ticktock", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest).To(Equal("kind: SpringCloudDeployerApplication\n"))
+	})
+
+	It("should return the platforms of the deployers", func() {
+		responses["GET "+serverUrl+"/deployers"] = `{"_embedded": {"deployers": [
+			{"name": "default", "type": "cloudfoundry", "description": "org = [o], space = [s]"}
+		]}}`
+
+		platforms, err := client.Platforms(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(platforms).To(Equal([]skipper.Platform{{Name: "default", Type: "cloudfoundry", Description: "org = [o], space = [s]"}}))
+	})
+
+	It("should propagate errors", func() {
+		_, err = client.Deployers(ctx)
+		Expect(err).To(MatchError("not found: GET https://skipper.example.com/api/deployers"))
+	})
+})
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper
+
+import (
+	"context"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+// Deployer deploys releases to a platform account, such as a Cloud Foundry org and space.
+type Deployer struct {
+	hal.Resource
+	Name        string           `json:"name"`
+	Type        string           `json:"type"`
+	Description string           `json:"description"`
+	Options     []DeployerOption `json:"options"`
+}
+
+// DeployerOption describes a deployment property supported by a deployer.
+type DeployerOption struct {
+	Id               string      `json:"id"`
+	Name             string      `json:"name"`
+	Type             string      `json:"type"`
+	Description      string      `json:"description"`
+	ShortDescription string      `json:"shortDescription"`
+	DefaultValue     interface{} `json:"defaultValue"`
+}
+
+// Platform is a platform account, such as a Cloud Foundry org and space, to which releases may be deployed.
+type Platform struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+func (c *client) Deployers(ctx context.Context) ([]Deployer, error) {
+	deployers := []Deployer{}
+	return deployers, c.api.Collect(ctx, "deployers", nil, "", &deployers)
+}
+
+// Platforms returns the platform accounts of the server's deployers, since Skipper configures one deployer for each
+// platform account.
+func (c *client) Platforms(ctx context.Context) ([]Platform, error) {
+	deployers, err := c.Deployers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	platforms := make([]Platform, 0, len(deployers))
+	for _, deployer := range deployers {
+		platforms = append(platforms, Platform{Name: deployer.Name, Type: deployer.Type, Description: deployer.Description})
+	}
+	return platforms, nil
+}
@@ -17,6 +17,7 @@
 package skipper
 
 import (
+	"context"
 	"crypto/sha256"
 	"hash"
 
@@ -39,11 +40,11 @@ type AboutResp struct {
 	}
 }
 
-func SkipperShellDownloadUrl(skipperServer string, authClient httpclient.AuthenticatedClient, accessToken string) (string, string, hash.Hash, error) {
+func SkipperShellDownloadUrl(ctx context.Context, skipperServer string, authClient httpclient.AuthenticatedClient, accessToken string) (string, string, hash.Hash, error) {
 	defaultHashFunc := sha256.New()
-	bodyReader, statusCode, _, err := authClient.DoAuthenticatedGet(skipperServer+"/about", accessToken)
+	bodyReader, statusCode, _, err := authClient.DoAuthenticatedGetContext(ctx, skipperServer+"/about", accessToken)
 	if err != nil {
-		return "", "", defaultHashFunc, fmt.Errorf("Skipper server error: %s", err)
+		return "", "", defaultHashFunc, fmt.Errorf("Skipper server error: %w", err)
 	}
 	if statusCode != http.StatusOK {
 		return "", "", defaultHashFunc, fmt.Errorf("Skipper server failed: %d", statusCode)
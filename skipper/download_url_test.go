@@ -17,6 +17,8 @@
 package skipper_test
 
 import (
+	"context"
+
 	. "github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/skipper"
 
 	"bytes"
@@ -69,13 +71,13 @@ var _ = Describe("SkipperShellDownloadUrl", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAuthClient.DoAuthenticatedGetReturns(ioutil.NopCloser(bytes.NewBufferString(payload)), getStatus, http.Header{}, getErr)
-		downloadUrl, checksum, hashFunc, err = SkipperShellDownloadUrl(skipperServerUrl, fakeAuthClient, testAccessToken)
+		fakeAuthClient.DoAuthenticatedGetContextReturns(ioutil.NopCloser(bytes.NewBufferString(payload)), getStatus, http.Header{}, getErr)
+		downloadUrl, checksum, hashFunc, err = SkipperShellDownloadUrl(context.Background(), skipperServerUrl, fakeAuthClient, testAccessToken)
 	})
 
 	It("should drive the /about endpoint with the supplied access token", func() {
-		Expect(fakeAuthClient.DoAuthenticatedGetCallCount()).To(Equal(1))
-		aboutUrl, accessToken := fakeAuthClient.DoAuthenticatedGetArgsForCall(0)
+		Expect(fakeAuthClient.DoAuthenticatedGetContextCallCount()).To(Equal(1))
+		_, aboutUrl, accessToken := fakeAuthClient.DoAuthenticatedGetContextArgsForCall(0)
 		Expect(aboutUrl).To(Equal(skipperServerUrl + "/about"))
 		Expect(accessToken).To(Equal(testAccessToken))
 	})
@@ -104,8 +106,8 @@ var _ = Describe("SkipperShellDownloadUrl", func() {
 
 	Context("when the /about endpoint returns a response reader which cannot be read", func() {
 		JustBeforeEach(func() {
-			fakeAuthClient.DoAuthenticatedGetReturns(ioutil.NopCloser(badReader{}), getStatus, http.Header{}, getErr)
-			downloadUrl, checksum, hashFunc, err = SkipperShellDownloadUrl(skipperServerUrl, fakeAuthClient, testAccessToken)
+			fakeAuthClient.DoAuthenticatedGetContextReturns(ioutil.NopCloser(badReader{}), getStatus, http.Header{}, getErr)
+			downloadUrl, checksum, hashFunc, err = SkipperShellDownloadUrl(context.Background(), skipperServerUrl, fakeAuthClient, testAccessToken)
 		})
 
 		It("should return a suitable error", func() {
@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+)
+
+type PackageMetadata struct {
+	hal.Resource
+	ApiVersion       string `json:"apiVersion"`
+	Origin           string `json:"origin"`
+	RepositoryId     int64  `json:"repositoryId"`
+	RepositoryName   string `json:"repositoryName"`
+	Kind             string `json:"kind"`
+	Name             string `json:"name"`
+	DisplayName      string `json:"displayName"`
+	Version          string `json:"version"`
+	PackageSourceUrl string `json:"packageSourceUrl"`
+	PackageHomeUrl   string `json:"packageHomeUrl"`
+	Tags             string `json:"tags"`
+	Maintainer       string `json:"maintainer"`
+	Description      string `json:"description"`
+	Sha256           string `json:"sha256"`
+	IconUrl          string `json:"iconUrl"`
+}
+
+// Package is a package as included in a release.
+type Package struct {
+	Metadata     PackageMetadata `json:"metadata"`
+	ConfigValues ConfigValues    `json:"configValues"`
+}
+
+// PackageIdentifier identifies a package in a repository. The repository name and package version may be empty to
+// select any repository and the latest version.
+type PackageIdentifier struct {
+	RepositoryName string `json:"repositoryName,omitempty"`
+	PackageName    string `json:"packageName"`
+	PackageVersion string `json:"packageVersion,omitempty"`
+}
+
+// UploadRequest uploads a zipped package to a local repository.
+type UploadRequest struct {
+	RepoName           string `json:"repoName"`
+	Name               string `json:"name"`
+	Version            string `json:"version"`
+	Extension          string `json:"extension"`
+	PackageFileAsBytes []byte `json:"packageFileAsBytes"`
+}
+
+type Repository struct {
+	hal.Resource
+	Name        string `json:"name"`
+	Url         string `json:"url"`
+	SourceUrl   string `json:"sourceUrl"`
+	Local       bool   `json:"local"`
+	Description string `json:"description"`
+	RepoOrder   int    `json:"repoOrder"`
+}
+
+func (c *client) Packages(ctx context.Context, search string) ([]PackageMetadata, error) {
+	rel, params := "packageMetadata", map[string]string(nil)
+	if search != "" {
+		rel, params = "packageMetadata/search", map[string]string{"name": search}
+	}
+	packages := []PackageMetadata{}
+	return packages, c.api.Collect(ctx, rel, params, "", &packages)
+}
+
+func (c *client) UploadPackage(ctx context.Context, upload UploadRequest) (*PackageMetadata, error) {
+	var metadata PackageMetadata
+	if err := c.api.Send(ctx, http.MethodPost, "package/upload", nil, hal.JSONBody(upload), &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (c *client) DeletePackage(ctx context.Context, name string) error {
+	return c.api.Send(ctx, http.MethodDelete, "package/delete", map[string]string{"name": name}, nil, nil)
+}
+
+func (c *client) Repositories(ctx context.Context) ([]Repository, error) {
+	repositories := []Repository{}
+	return repositories, c.api.Collect(ctx, "repositories", nil, "", &repositories)
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (C) 2018-Present Pivotal Software, Inc. All rights reserved.
+ *
+ * This program and the accompanying materials are made available under
+ * the terms of the under the Apache License, Version 2.0 (the "License”);
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package skipper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/hal"
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/httpclient"
+)
+
+// Release status codes reported by the server.
+const (
+	StatusDeployed = "DEPLOYED"
+	StatusDeleted  = "DELETED"
+	StatusUnknown  = "UNKNOWN"
+	StatusFailed   = "FAILED"
+)
+
+type Release struct {
+	hal.Resource
+	Name         string       `json:"name"`
+	Version      int          `json:"version"`
+	Info         Info         `json:"info"`
+	Pkg          Package      `json:"pkg"`
+	ConfigValues ConfigValues `json:"configValues"`
+	Manifest     Manifest     `json:"manifest"`
+	PlatformName string       `json:"platformName"`
+}
+
+// Info describes the status and deployment history of a release.
+type Info struct {
+	hal.Resource
+	Status        Status   `json:"status"`
+	FirstDeployed hal.Time `json:"firstDeployed"`
+	LastDeployed  hal.Time `json:"lastDeployed"`
+	Deleted       hal.Time `json:"deleted"`
+	Description   string   `json:"description"`
+}
+
+type Status struct {
+	StatusCode string `json:"statusCode"`
+
+	// PlatformStatus holds the platform specific status of the applications of the release, encoded as JSON.
+	PlatformStatus string `json:"platformStatus"`
+}
+
+// ConfigValues holds configuration values as YAML.
+type ConfigValues struct {
+	Raw string `json:"raw,omitempty"`
+}
+
+// Manifest holds the manifest of a release as YAML.
+type Manifest struct {
+	Data string `json:"data"`
+}
+
+// InstallRequest installs a package as a release with the given name on the given platform.
+type InstallRequest struct {
+	PackageIdentifier PackageIdentifier `json:"packageIdentifier"`
+	InstallProperties InstallProperties `json:"installProperties"`
+}
+
+type InstallProperties struct {
+	ReleaseName  string       `json:"releaseName"`
+	PlatformName string       `json:"platformName,omitempty"`
+	ConfigValues ConfigValues `json:"configValues"`
+}
+
+// UpgradeRequest upgrades a release to the given package using the given configuration values. If Force is true,
+// the applications of the release, or only those named by AppNames, are upgraded even if they are unchanged.
+type UpgradeRequest struct {
+	PackageIdentifier PackageIdentifier `json:"packageIdentifier"`
+	UpgradeProperties UpgradeProperties `json:"upgradeProperties"`
+	Timeout           int64             `json:"timeout,omitempty"`
+	Force             bool              `json:"force"`
+	AppNames          []string          `json:"appNames,omitempty"`
+}
+
+type UpgradeProperties struct {
+	ReleaseName  string       `json:"releaseName"`
+	ConfigValues ConfigValues `json:"configValues"`
+}
+
+func (c *client) Releases(ctx context.Context, name string) ([]Release, error) {
+	rel, params := "release/list", map[string]string(nil)
+	if name != "" {
+		rel, params = "release/list/name", map[string]string{"name": name}
+	}
+	releases := []Release{}
+	return releases, c.api.Collect(ctx, rel, params, "", &releases)
+}
+
+func (c *client) Install(ctx context.Context, install InstallRequest) (*Release, error) {
+	var release Release
+	if err := c.api.Send(ctx, http.MethodPost, "package/install", nil, hal.JSONBody(install), &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+func (c *client) Upgrade(ctx context.Context, upgrade UpgradeRequest) (*Release, error) {
+	var release Release
+	if err := c.api.Send(ctx, http.MethodPost, "release/upgrade", nil, hal.JSONBody(upgrade), &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+func (c *client) Rollback(ctx context.Context, name string, version int) (*Release, error) {
+	rollback := map[string]interface{}{"releaseName": name, "version": version}
+	var release Release
+	if err := c.api.Send(ctx, http.MethodPost, "release/rollback", nil, hal.JSONBody(rollback), &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+func (c *client) DeleteRelease(ctx context.Context, name string, deletePackage bool) error {
+	return c.api.Send(ctx, http.MethodDelete, "release/delete", map[string]string{"name": name}, func(request *httpclient.Request) {
+		if deletePackage {
+			request.WithQuery("deletePackage", "true")
+		}
+	}, nil)
+}
+
+func (c *client) Status(ctx context.Context, name string, version int) (*Info, error) {
+	rel, params := versioned("release/status", name, version)
+	var info Info
+	if err := c.api.Get(ctx, rel, params, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (c *client) History(ctx context.Context, name string) ([]Release, error) {
+	releases := []Release{}
+	if err := c.api.Collect(ctx, "release/history/name", map[string]string{"name": name}, "", &releases); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+	return releases, nil
+}
+
+func (c *client) Manifest(ctx context.Context, name string, version int) (string, error) {
+	rel, params := versioned("release/manifest", name, version)
+	href, err := c.api.Href(ctx, rel, params)
+	if err != nil {
+		return "", err
+	}
+	text, err := c.hal.Text(ctx, httpclient.NewRequest(http.MethodGet, href))
+	if err != nil {
+		return "", err
+	}
+
+	// Some server versions return the manifest as a resource rather than as text.
+	var manifest Manifest
+	if err := json.Unmarshal([]byte(text), &manifest); err == nil && manifest.Data != "" {
+		return manifest.Data, nil
+	}
+	return text, nil
+}
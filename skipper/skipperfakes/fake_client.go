@@ -0,0 +1,1308 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package skipperfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pivotal-cf/spring-cloud-dataflow-for-pcf-cli-plugin/skipper"
+)
+
+type FakeClient struct {
+	AboutStub        func(context.Context) (*skipper.AboutInfo, error)
+	aboutMutex       sync.RWMutex
+	aboutArgsForCall []struct {
+		arg1 context.Context
+	}
+	aboutReturns struct {
+		result1 *skipper.AboutInfo
+		result2 error
+	}
+	aboutReturnsOnCall map[int]struct {
+		result1 *skipper.AboutInfo
+		result2 error
+	}
+	DeletePackageStub        func(context.Context, string) error
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deletePackageReturns struct {
+		result1 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteReleaseStub        func(context.Context, string, bool) error
+	deleteReleaseMutex       sync.RWMutex
+	deleteReleaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	deleteReleaseReturns struct {
+		result1 error
+	}
+	deleteReleaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeployersStub        func(context.Context) ([]skipper.Deployer, error)
+	deployersMutex       sync.RWMutex
+	deployersArgsForCall []struct {
+		arg1 context.Context
+	}
+	deployersReturns struct {
+		result1 []skipper.Deployer
+		result2 error
+	}
+	deployersReturnsOnCall map[int]struct {
+		result1 []skipper.Deployer
+		result2 error
+	}
+	HistoryStub        func(context.Context, string) ([]skipper.Release, error)
+	historyMutex       sync.RWMutex
+	historyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	historyReturns struct {
+		result1 []skipper.Release
+		result2 error
+	}
+	historyReturnsOnCall map[int]struct {
+		result1 []skipper.Release
+		result2 error
+	}
+	InstallStub        func(context.Context, skipper.InstallRequest) (*skipper.Release, error)
+	installMutex       sync.RWMutex
+	installArgsForCall []struct {
+		arg1 context.Context
+		arg2 skipper.InstallRequest
+	}
+	installReturns struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	installReturnsOnCall map[int]struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	ManifestStub        func(context.Context, string, int) (string, error)
+	manifestMutex       sync.RWMutex
+	manifestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	manifestReturns struct {
+		result1 string
+		result2 error
+	}
+	manifestReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	PackagesStub        func(context.Context, string) ([]skipper.PackageMetadata, error)
+	packagesMutex       sync.RWMutex
+	packagesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	packagesReturns struct {
+		result1 []skipper.PackageMetadata
+		result2 error
+	}
+	packagesReturnsOnCall map[int]struct {
+		result1 []skipper.PackageMetadata
+		result2 error
+	}
+	PlatformsStub        func(context.Context) ([]skipper.Platform, error)
+	platformsMutex       sync.RWMutex
+	platformsArgsForCall []struct {
+		arg1 context.Context
+	}
+	platformsReturns struct {
+		result1 []skipper.Platform
+		result2 error
+	}
+	platformsReturnsOnCall map[int]struct {
+		result1 []skipper.Platform
+		result2 error
+	}
+	ReleasesStub        func(context.Context, string) ([]skipper.Release, error)
+	releasesMutex       sync.RWMutex
+	releasesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	releasesReturns struct {
+		result1 []skipper.Release
+		result2 error
+	}
+	releasesReturnsOnCall map[int]struct {
+		result1 []skipper.Release
+		result2 error
+	}
+	RepositoriesStub        func(context.Context) ([]skipper.Repository, error)
+	repositoriesMutex       sync.RWMutex
+	repositoriesArgsForCall []struct {
+		arg1 context.Context
+	}
+	repositoriesReturns struct {
+		result1 []skipper.Repository
+		result2 error
+	}
+	repositoriesReturnsOnCall map[int]struct {
+		result1 []skipper.Repository
+		result2 error
+	}
+	RollbackStub        func(context.Context, string, int) (*skipper.Release, error)
+	rollbackMutex       sync.RWMutex
+	rollbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	rollbackReturns struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	rollbackReturnsOnCall map[int]struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	ServerUrlStub        func() string
+	serverUrlMutex       sync.RWMutex
+	serverUrlArgsForCall []struct {
+	}
+	serverUrlReturns struct {
+		result1 string
+	}
+	serverUrlReturnsOnCall map[int]struct {
+		result1 string
+	}
+	StatusStub        func(context.Context, string, int) (*skipper.Info, error)
+	statusMutex       sync.RWMutex
+	statusArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}
+	statusReturns struct {
+		result1 *skipper.Info
+		result2 error
+	}
+	statusReturnsOnCall map[int]struct {
+		result1 *skipper.Info
+		result2 error
+	}
+	UpgradeStub        func(context.Context, skipper.UpgradeRequest) (*skipper.Release, error)
+	upgradeMutex       sync.RWMutex
+	upgradeArgsForCall []struct {
+		arg1 context.Context
+		arg2 skipper.UpgradeRequest
+	}
+	upgradeReturns struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	upgradeReturnsOnCall map[int]struct {
+		result1 *skipper.Release
+		result2 error
+	}
+	UploadPackageStub        func(context.Context, skipper.UploadRequest) (*skipper.PackageMetadata, error)
+	uploadPackageMutex       sync.RWMutex
+	uploadPackageArgsForCall []struct {
+		arg1 context.Context
+		arg2 skipper.UploadRequest
+	}
+	uploadPackageReturns struct {
+		result1 *skipper.PackageMetadata
+		result2 error
+	}
+	uploadPackageReturnsOnCall map[int]struct {
+		result1 *skipper.PackageMetadata
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) About(arg1 context.Context) (*skipper.AboutInfo, error) {
+	fake.aboutMutex.Lock()
+	ret, specificReturn := fake.aboutReturnsOnCall[len(fake.aboutArgsForCall)]
+	fake.aboutArgsForCall = append(fake.aboutArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.AboutStub
+	fakeReturns := fake.aboutReturns
+	fake.recordInvocation("About", []interface{}{arg1})
+	fake.aboutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AboutCallCount() int {
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	return len(fake.aboutArgsForCall)
+}
+
+func (fake *FakeClient) AboutCalls(stub func(context.Context) (*skipper.AboutInfo, error)) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = stub
+}
+
+func (fake *FakeClient) AboutArgsForCall(i int) context.Context {
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	argsForCall := fake.aboutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) AboutReturns(result1 *skipper.AboutInfo, result2 error) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = nil
+	fake.aboutReturns = struct {
+		result1 *skipper.AboutInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AboutReturnsOnCall(i int, result1 *skipper.AboutInfo, result2 error) {
+	fake.aboutMutex.Lock()
+	defer fake.aboutMutex.Unlock()
+	fake.AboutStub = nil
+	if fake.aboutReturnsOnCall == nil {
+		fake.aboutReturnsOnCall = make(map[int]struct {
+			result1 *skipper.AboutInfo
+			result2 error
+		})
+	}
+	fake.aboutReturnsOnCall[i] = struct {
+		result1 *skipper.AboutInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeletePackage(arg1 context.Context, arg2 string) error {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeletePackageStub
+	fakeReturns := fake.deletePackageReturns
+	fake.recordInvocation("DeletePackage", []interface{}{arg1, arg2})
+	fake.deletePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeClient) DeletePackageCalls(stub func(context.Context, string) error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = stub
+}
+
+func (fake *FakeClient) DeletePackageArgsForCall(i int) (context.Context, string) {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	argsForCall := fake.deletePackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DeletePackageReturns(result1 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeletePackageReturnsOnCall(i int, result1 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteRelease(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.deleteReleaseMutex.Lock()
+	ret, specificReturn := fake.deleteReleaseReturnsOnCall[len(fake.deleteReleaseArgsForCall)]
+	fake.deleteReleaseArgsForCall = append(fake.deleteReleaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteReleaseStub
+	fakeReturns := fake.deleteReleaseReturns
+	fake.recordInvocation("DeleteRelease", []interface{}{arg1, arg2, arg3})
+	fake.deleteReleaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteReleaseCallCount() int {
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	return len(fake.deleteReleaseArgsForCall)
+}
+
+func (fake *FakeClient) DeleteReleaseCalls(stub func(context.Context, string, bool) error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = stub
+}
+
+func (fake *FakeClient) DeleteReleaseArgsForCall(i int) (context.Context, string, bool) {
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	argsForCall := fake.deleteReleaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteReleaseReturns(result1 error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = nil
+	fake.deleteReleaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteReleaseReturnsOnCall(i int, result1 error) {
+	fake.deleteReleaseMutex.Lock()
+	defer fake.deleteReleaseMutex.Unlock()
+	fake.DeleteReleaseStub = nil
+	if fake.deleteReleaseReturnsOnCall == nil {
+		fake.deleteReleaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReleaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Deployers(arg1 context.Context) ([]skipper.Deployer, error) {
+	fake.deployersMutex.Lock()
+	ret, specificReturn := fake.deployersReturnsOnCall[len(fake.deployersArgsForCall)]
+	fake.deployersArgsForCall = append(fake.deployersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.DeployersStub
+	fakeReturns := fake.deployersReturns
+	fake.recordInvocation("Deployers", []interface{}{arg1})
+	fake.deployersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeployersCallCount() int {
+	fake.deployersMutex.RLock()
+	defer fake.deployersMutex.RUnlock()
+	return len(fake.deployersArgsForCall)
+}
+
+func (fake *FakeClient) DeployersCalls(stub func(context.Context) ([]skipper.Deployer, error)) {
+	fake.deployersMutex.Lock()
+	defer fake.deployersMutex.Unlock()
+	fake.DeployersStub = stub
+}
+
+func (fake *FakeClient) DeployersArgsForCall(i int) context.Context {
+	fake.deployersMutex.RLock()
+	defer fake.deployersMutex.RUnlock()
+	argsForCall := fake.deployersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeployersReturns(result1 []skipper.Deployer, result2 error) {
+	fake.deployersMutex.Lock()
+	defer fake.deployersMutex.Unlock()
+	fake.DeployersStub = nil
+	fake.deployersReturns = struct {
+		result1 []skipper.Deployer
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeployersReturnsOnCall(i int, result1 []skipper.Deployer, result2 error) {
+	fake.deployersMutex.Lock()
+	defer fake.deployersMutex.Unlock()
+	fake.DeployersStub = nil
+	if fake.deployersReturnsOnCall == nil {
+		fake.deployersReturnsOnCall = make(map[int]struct {
+			result1 []skipper.Deployer
+			result2 error
+		})
+	}
+	fake.deployersReturnsOnCall[i] = struct {
+		result1 []skipper.Deployer
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) History(arg1 context.Context, arg2 string) ([]skipper.Release, error) {
+	fake.historyMutex.Lock()
+	ret, specificReturn := fake.historyReturnsOnCall[len(fake.historyArgsForCall)]
+	fake.historyArgsForCall = append(fake.historyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HistoryStub
+	fakeReturns := fake.historyReturns
+	fake.recordInvocation("History", []interface{}{arg1, arg2})
+	fake.historyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) HistoryCallCount() int {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	return len(fake.historyArgsForCall)
+}
+
+func (fake *FakeClient) HistoryCalls(stub func(context.Context, string) ([]skipper.Release, error)) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = stub
+}
+
+func (fake *FakeClient) HistoryArgsForCall(i int) (context.Context, string) {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	argsForCall := fake.historyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) HistoryReturns(result1 []skipper.Release, result2 error) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	fake.historyReturns = struct {
+		result1 []skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HistoryReturnsOnCall(i int, result1 []skipper.Release, result2 error) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	if fake.historyReturnsOnCall == nil {
+		fake.historyReturnsOnCall = make(map[int]struct {
+			result1 []skipper.Release
+			result2 error
+		})
+	}
+	fake.historyReturnsOnCall[i] = struct {
+		result1 []skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Install(arg1 context.Context, arg2 skipper.InstallRequest) (*skipper.Release, error) {
+	fake.installMutex.Lock()
+	ret, specificReturn := fake.installReturnsOnCall[len(fake.installArgsForCall)]
+	fake.installArgsForCall = append(fake.installArgsForCall, struct {
+		arg1 context.Context
+		arg2 skipper.InstallRequest
+	}{arg1, arg2})
+	stub := fake.InstallStub
+	fakeReturns := fake.installReturns
+	fake.recordInvocation("Install", []interface{}{arg1, arg2})
+	fake.installMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) InstallCallCount() int {
+	fake.installMutex.RLock()
+	defer fake.installMutex.RUnlock()
+	return len(fake.installArgsForCall)
+}
+
+func (fake *FakeClient) InstallCalls(stub func(context.Context, skipper.InstallRequest) (*skipper.Release, error)) {
+	fake.installMutex.Lock()
+	defer fake.installMutex.Unlock()
+	fake.InstallStub = stub
+}
+
+func (fake *FakeClient) InstallArgsForCall(i int) (context.Context, skipper.InstallRequest) {
+	fake.installMutex.RLock()
+	defer fake.installMutex.RUnlock()
+	argsForCall := fake.installArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) InstallReturns(result1 *skipper.Release, result2 error) {
+	fake.installMutex.Lock()
+	defer fake.installMutex.Unlock()
+	fake.InstallStub = nil
+	fake.installReturns = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) InstallReturnsOnCall(i int, result1 *skipper.Release, result2 error) {
+	fake.installMutex.Lock()
+	defer fake.installMutex.Unlock()
+	fake.InstallStub = nil
+	if fake.installReturnsOnCall == nil {
+		fake.installReturnsOnCall = make(map[int]struct {
+			result1 *skipper.Release
+			result2 error
+		})
+	}
+	fake.installReturnsOnCall[i] = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Manifest(arg1 context.Context, arg2 string, arg3 int) (string, error) {
+	fake.manifestMutex.Lock()
+	ret, specificReturn := fake.manifestReturnsOnCall[len(fake.manifestArgsForCall)]
+	fake.manifestArgsForCall = append(fake.manifestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ManifestStub
+	fakeReturns := fake.manifestReturns
+	fake.recordInvocation("Manifest", []interface{}{arg1, arg2, arg3})
+	fake.manifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ManifestCallCount() int {
+	fake.manifestMutex.RLock()
+	defer fake.manifestMutex.RUnlock()
+	return len(fake.manifestArgsForCall)
+}
+
+func (fake *FakeClient) ManifestCalls(stub func(context.Context, string, int) (string, error)) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = stub
+}
+
+func (fake *FakeClient) ManifestArgsForCall(i int) (context.Context, string, int) {
+	fake.manifestMutex.RLock()
+	defer fake.manifestMutex.RUnlock()
+	argsForCall := fake.manifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ManifestReturns(result1 string, result2 error) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = nil
+	fake.manifestReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ManifestReturnsOnCall(i int, result1 string, result2 error) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = nil
+	if fake.manifestReturnsOnCall == nil {
+		fake.manifestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.manifestReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Packages(arg1 context.Context, arg2 string) ([]skipper.PackageMetadata, error) {
+	fake.packagesMutex.Lock()
+	ret, specificReturn := fake.packagesReturnsOnCall[len(fake.packagesArgsForCall)]
+	fake.packagesArgsForCall = append(fake.packagesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PackagesStub
+	fakeReturns := fake.packagesReturns
+	fake.recordInvocation("Packages", []interface{}{arg1, arg2})
+	fake.packagesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PackagesCallCount() int {
+	fake.packagesMutex.RLock()
+	defer fake.packagesMutex.RUnlock()
+	return len(fake.packagesArgsForCall)
+}
+
+func (fake *FakeClient) PackagesCalls(stub func(context.Context, string) ([]skipper.PackageMetadata, error)) {
+	fake.packagesMutex.Lock()
+	defer fake.packagesMutex.Unlock()
+	fake.PackagesStub = stub
+}
+
+func (fake *FakeClient) PackagesArgsForCall(i int) (context.Context, string) {
+	fake.packagesMutex.RLock()
+	defer fake.packagesMutex.RUnlock()
+	argsForCall := fake.packagesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) PackagesReturns(result1 []skipper.PackageMetadata, result2 error) {
+	fake.packagesMutex.Lock()
+	defer fake.packagesMutex.Unlock()
+	fake.PackagesStub = nil
+	fake.packagesReturns = struct {
+		result1 []skipper.PackageMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PackagesReturnsOnCall(i int, result1 []skipper.PackageMetadata, result2 error) {
+	fake.packagesMutex.Lock()
+	defer fake.packagesMutex.Unlock()
+	fake.PackagesStub = nil
+	if fake.packagesReturnsOnCall == nil {
+		fake.packagesReturnsOnCall = make(map[int]struct {
+			result1 []skipper.PackageMetadata
+			result2 error
+		})
+	}
+	fake.packagesReturnsOnCall[i] = struct {
+		result1 []skipper.PackageMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Platforms(arg1 context.Context) ([]skipper.Platform, error) {
+	fake.platformsMutex.Lock()
+	ret, specificReturn := fake.platformsReturnsOnCall[len(fake.platformsArgsForCall)]
+	fake.platformsArgsForCall = append(fake.platformsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PlatformsStub
+	fakeReturns := fake.platformsReturns
+	fake.recordInvocation("Platforms", []interface{}{arg1})
+	fake.platformsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PlatformsCallCount() int {
+	fake.platformsMutex.RLock()
+	defer fake.platformsMutex.RUnlock()
+	return len(fake.platformsArgsForCall)
+}
+
+func (fake *FakeClient) PlatformsCalls(stub func(context.Context) ([]skipper.Platform, error)) {
+	fake.platformsMutex.Lock()
+	defer fake.platformsMutex.Unlock()
+	fake.PlatformsStub = stub
+}
+
+func (fake *FakeClient) PlatformsArgsForCall(i int) context.Context {
+	fake.platformsMutex.RLock()
+	defer fake.platformsMutex.RUnlock()
+	argsForCall := fake.platformsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) PlatformsReturns(result1 []skipper.Platform, result2 error) {
+	fake.platformsMutex.Lock()
+	defer fake.platformsMutex.Unlock()
+	fake.PlatformsStub = nil
+	fake.platformsReturns = struct {
+		result1 []skipper.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PlatformsReturnsOnCall(i int, result1 []skipper.Platform, result2 error) {
+	fake.platformsMutex.Lock()
+	defer fake.platformsMutex.Unlock()
+	fake.PlatformsStub = nil
+	if fake.platformsReturnsOnCall == nil {
+		fake.platformsReturnsOnCall = make(map[int]struct {
+			result1 []skipper.Platform
+			result2 error
+		})
+	}
+	fake.platformsReturnsOnCall[i] = struct {
+		result1 []skipper.Platform
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Releases(arg1 context.Context, arg2 string) ([]skipper.Release, error) {
+	fake.releasesMutex.Lock()
+	ret, specificReturn := fake.releasesReturnsOnCall[len(fake.releasesArgsForCall)]
+	fake.releasesArgsForCall = append(fake.releasesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReleasesStub
+	fakeReturns := fake.releasesReturns
+	fake.recordInvocation("Releases", []interface{}{arg1, arg2})
+	fake.releasesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReleasesCallCount() int {
+	fake.releasesMutex.RLock()
+	defer fake.releasesMutex.RUnlock()
+	return len(fake.releasesArgsForCall)
+}
+
+func (fake *FakeClient) ReleasesCalls(stub func(context.Context, string) ([]skipper.Release, error)) {
+	fake.releasesMutex.Lock()
+	defer fake.releasesMutex.Unlock()
+	fake.ReleasesStub = stub
+}
+
+func (fake *FakeClient) ReleasesArgsForCall(i int) (context.Context, string) {
+	fake.releasesMutex.RLock()
+	defer fake.releasesMutex.RUnlock()
+	argsForCall := fake.releasesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ReleasesReturns(result1 []skipper.Release, result2 error) {
+	fake.releasesMutex.Lock()
+	defer fake.releasesMutex.Unlock()
+	fake.ReleasesStub = nil
+	fake.releasesReturns = struct {
+		result1 []skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReleasesReturnsOnCall(i int, result1 []skipper.Release, result2 error) {
+	fake.releasesMutex.Lock()
+	defer fake.releasesMutex.Unlock()
+	fake.ReleasesStub = nil
+	if fake.releasesReturnsOnCall == nil {
+		fake.releasesReturnsOnCall = make(map[int]struct {
+			result1 []skipper.Release
+			result2 error
+		})
+	}
+	fake.releasesReturnsOnCall[i] = struct {
+		result1 []skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Repositories(arg1 context.Context) ([]skipper.Repository, error) {
+	fake.repositoriesMutex.Lock()
+	ret, specificReturn := fake.repositoriesReturnsOnCall[len(fake.repositoriesArgsForCall)]
+	fake.repositoriesArgsForCall = append(fake.repositoriesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RepositoriesStub
+	fakeReturns := fake.repositoriesReturns
+	fake.recordInvocation("Repositories", []interface{}{arg1})
+	fake.repositoriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RepositoriesCallCount() int {
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	return len(fake.repositoriesArgsForCall)
+}
+
+func (fake *FakeClient) RepositoriesCalls(stub func(context.Context) ([]skipper.Repository, error)) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = stub
+}
+
+func (fake *FakeClient) RepositoriesArgsForCall(i int) context.Context {
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	argsForCall := fake.repositoriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RepositoriesReturns(result1 []skipper.Repository, result2 error) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = nil
+	fake.repositoriesReturns = struct {
+		result1 []skipper.Repository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RepositoriesReturnsOnCall(i int, result1 []skipper.Repository, result2 error) {
+	fake.repositoriesMutex.Lock()
+	defer fake.repositoriesMutex.Unlock()
+	fake.RepositoriesStub = nil
+	if fake.repositoriesReturnsOnCall == nil {
+		fake.repositoriesReturnsOnCall = make(map[int]struct {
+			result1 []skipper.Repository
+			result2 error
+		})
+	}
+	fake.repositoriesReturnsOnCall[i] = struct {
+		result1 []skipper.Repository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Rollback(arg1 context.Context, arg2 string, arg3 int) (*skipper.Release, error) {
+	fake.rollbackMutex.Lock()
+	ret, specificReturn := fake.rollbackReturnsOnCall[len(fake.rollbackArgsForCall)]
+	fake.rollbackArgsForCall = append(fake.rollbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackStub
+	fakeReturns := fake.rollbackReturns
+	fake.recordInvocation("Rollback", []interface{}{arg1, arg2, arg3})
+	fake.rollbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RollbackCallCount() int {
+	fake.rollbackMutex.RLock()
+	defer fake.rollbackMutex.RUnlock()
+	return len(fake.rollbackArgsForCall)
+}
+
+func (fake *FakeClient) RollbackCalls(stub func(context.Context, string, int) (*skipper.Release, error)) {
+	fake.rollbackMutex.Lock()
+	defer fake.rollbackMutex.Unlock()
+	fake.RollbackStub = stub
+}
+
+func (fake *FakeClient) RollbackArgsForCall(i int) (context.Context, string, int) {
+	fake.rollbackMutex.RLock()
+	defer fake.rollbackMutex.RUnlock()
+	argsForCall := fake.rollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RollbackReturns(result1 *skipper.Release, result2 error) {
+	fake.rollbackMutex.Lock()
+	defer fake.rollbackMutex.Unlock()
+	fake.RollbackStub = nil
+	fake.rollbackReturns = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RollbackReturnsOnCall(i int, result1 *skipper.Release, result2 error) {
+	fake.rollbackMutex.Lock()
+	defer fake.rollbackMutex.Unlock()
+	fake.RollbackStub = nil
+	if fake.rollbackReturnsOnCall == nil {
+		fake.rollbackReturnsOnCall = make(map[int]struct {
+			result1 *skipper.Release
+			result2 error
+		})
+	}
+	fake.rollbackReturnsOnCall[i] = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ServerUrl() string {
+	fake.serverUrlMutex.Lock()
+	ret, specificReturn := fake.serverUrlReturnsOnCall[len(fake.serverUrlArgsForCall)]
+	fake.serverUrlArgsForCall = append(fake.serverUrlArgsForCall, struct {
+	}{})
+	stub := fake.ServerUrlStub
+	fakeReturns := fake.serverUrlReturns
+	fake.recordInvocation("ServerUrl", []interface{}{})
+	fake.serverUrlMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ServerUrlCallCount() int {
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	return len(fake.serverUrlArgsForCall)
+}
+
+func (fake *FakeClient) ServerUrlCalls(stub func() string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = stub
+}
+
+func (fake *FakeClient) ServerUrlReturns(result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	fake.serverUrlReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) ServerUrlReturnsOnCall(i int, result1 string) {
+	fake.serverUrlMutex.Lock()
+	defer fake.serverUrlMutex.Unlock()
+	fake.ServerUrlStub = nil
+	if fake.serverUrlReturnsOnCall == nil {
+		fake.serverUrlReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.serverUrlReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) Status(arg1 context.Context, arg2 string, arg3 int) (*skipper.Info, error) {
+	fake.statusMutex.Lock()
+	ret, specificReturn := fake.statusReturnsOnCall[len(fake.statusArgsForCall)]
+	fake.statusArgsForCall = append(fake.statusArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.StatusStub
+	fakeReturns := fake.statusReturns
+	fake.recordInvocation("Status", []interface{}{arg1, arg2, arg3})
+	fake.statusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StatusCallCount() int {
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	return len(fake.statusArgsForCall)
+}
+
+func (fake *FakeClient) StatusCalls(stub func(context.Context, string, int) (*skipper.Info, error)) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = stub
+}
+
+func (fake *FakeClient) StatusArgsForCall(i int) (context.Context, string, int) {
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	argsForCall := fake.statusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) StatusReturns(result1 *skipper.Info, result2 error) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	fake.statusReturns = struct {
+		result1 *skipper.Info
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StatusReturnsOnCall(i int, result1 *skipper.Info, result2 error) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	if fake.statusReturnsOnCall == nil {
+		fake.statusReturnsOnCall = make(map[int]struct {
+			result1 *skipper.Info
+			result2 error
+		})
+	}
+	fake.statusReturnsOnCall[i] = struct {
+		result1 *skipper.Info
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Upgrade(arg1 context.Context, arg2 skipper.UpgradeRequest) (*skipper.Release, error) {
+	fake.upgradeMutex.Lock()
+	ret, specificReturn := fake.upgradeReturnsOnCall[len(fake.upgradeArgsForCall)]
+	fake.upgradeArgsForCall = append(fake.upgradeArgsForCall, struct {
+		arg1 context.Context
+		arg2 skipper.UpgradeRequest
+	}{arg1, arg2})
+	stub := fake.UpgradeStub
+	fakeReturns := fake.upgradeReturns
+	fake.recordInvocation("Upgrade", []interface{}{arg1, arg2})
+	fake.upgradeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpgradeCallCount() int {
+	fake.upgradeMutex.RLock()
+	defer fake.upgradeMutex.RUnlock()
+	return len(fake.upgradeArgsForCall)
+}
+
+func (fake *FakeClient) UpgradeCalls(stub func(context.Context, skipper.UpgradeRequest) (*skipper.Release, error)) {
+	fake.upgradeMutex.Lock()
+	defer fake.upgradeMutex.Unlock()
+	fake.UpgradeStub = stub
+}
+
+func (fake *FakeClient) UpgradeArgsForCall(i int) (context.Context, skipper.UpgradeRequest) {
+	fake.upgradeMutex.RLock()
+	defer fake.upgradeMutex.RUnlock()
+	argsForCall := fake.upgradeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) UpgradeReturns(result1 *skipper.Release, result2 error) {
+	fake.upgradeMutex.Lock()
+	defer fake.upgradeMutex.Unlock()
+	fake.UpgradeStub = nil
+	fake.upgradeReturns = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpgradeReturnsOnCall(i int, result1 *skipper.Release, result2 error) {
+	fake.upgradeMutex.Lock()
+	defer fake.upgradeMutex.Unlock()
+	fake.UpgradeStub = nil
+	if fake.upgradeReturnsOnCall == nil {
+		fake.upgradeReturnsOnCall = make(map[int]struct {
+			result1 *skipper.Release
+			result2 error
+		})
+	}
+	fake.upgradeReturnsOnCall[i] = struct {
+		result1 *skipper.Release
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UploadPackage(arg1 context.Context, arg2 skipper.UploadRequest) (*skipper.PackageMetadata, error) {
+	fake.uploadPackageMutex.Lock()
+	ret, specificReturn := fake.uploadPackageReturnsOnCall[len(fake.uploadPackageArgsForCall)]
+	fake.uploadPackageArgsForCall = append(fake.uploadPackageArgsForCall, struct {
+		arg1 context.Context
+		arg2 skipper.UploadRequest
+	}{arg1, arg2})
+	stub := fake.UploadPackageStub
+	fakeReturns := fake.uploadPackageReturns
+	fake.recordInvocation("UploadPackage", []interface{}{arg1, arg2})
+	fake.uploadPackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UploadPackageCallCount() int {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	return len(fake.uploadPackageArgsForCall)
+}
+
+func (fake *FakeClient) UploadPackageCalls(stub func(context.Context, skipper.UploadRequest) (*skipper.PackageMetadata, error)) {
+	fake.uploadPackageMutex.Lock()
+	defer fake.uploadPackageMutex.Unlock()
+	fake.UploadPackageStub = stub
+}
+
+func (fake *FakeClient) UploadPackageArgsForCall(i int) (context.Context, skipper.UploadRequest) {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	argsForCall := fake.uploadPackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) UploadPackageReturns(result1 *skipper.PackageMetadata, result2 error) {
+	fake.uploadPackageMutex.Lock()
+	defer fake.uploadPackageMutex.Unlock()
+	fake.UploadPackageStub = nil
+	fake.uploadPackageReturns = struct {
+		result1 *skipper.PackageMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UploadPackageReturnsOnCall(i int, result1 *skipper.PackageMetadata, result2 error) {
+	fake.uploadPackageMutex.Lock()
+	defer fake.uploadPackageMutex.Unlock()
+	fake.UploadPackageStub = nil
+	if fake.uploadPackageReturnsOnCall == nil {
+		fake.uploadPackageReturnsOnCall = make(map[int]struct {
+			result1 *skipper.PackageMetadata
+			result2 error
+		})
+	}
+	fake.uploadPackageReturnsOnCall[i] = struct {
+		result1 *skipper.PackageMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.aboutMutex.RLock()
+	defer fake.aboutMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.deleteReleaseMutex.RLock()
+	defer fake.deleteReleaseMutex.RUnlock()
+	fake.deployersMutex.RLock()
+	defer fake.deployersMutex.RUnlock()
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	fake.installMutex.RLock()
+	defer fake.installMutex.RUnlock()
+	fake.manifestMutex.RLock()
+	defer fake.manifestMutex.RUnlock()
+	fake.packagesMutex.RLock()
+	defer fake.packagesMutex.RUnlock()
+	fake.platformsMutex.RLock()
+	defer fake.platformsMutex.RUnlock()
+	fake.releasesMutex.RLock()
+	defer fake.releasesMutex.RUnlock()
+	fake.repositoriesMutex.RLock()
+	defer fake.repositoriesMutex.RUnlock()
+	fake.rollbackMutex.RLock()
+	defer fake.rollbackMutex.RUnlock()
+	fake.serverUrlMutex.RLock()
+	defer fake.serverUrlMutex.RUnlock()
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	fake.upgradeMutex.RLock()
+	defer fake.upgradeMutex.RUnlock()
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ skipper.Client = new(FakeClient)